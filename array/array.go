@@ -1,29 +1,69 @@
 package array
 
-import "errors"
+import (
+	"errors"
+
+	"goCollections/collections"
+)
 
 // Array represents a collection of values.
 type Array struct {
 	values   []interface{} // The underlying slice to store the values.
 	isStatic bool          // Indicates whether the array is static or dynamic.
+	equal    EqualFunc     // Compares values in Contains and IndexOf; nil means ==.
+	modCount int           // Incremented on every change, so transactions can detect changes made outside of them.
+
+	observers collections.Observers[interface{}] // The subscribers to changes of the array.
+}
+
+var _ collections.List[interface{}] = (*Array)(nil)
+
+// EqualFunc reports whether two values are equal.
+type EqualFunc func(a, b interface{}) bool
+
+// Option configures an array.
+type Option func(*config)
+
+// config holds the settings of an array.
+type config struct {
+	equal EqualFunc
+}
+
+// WithEqual sets the function Contains and IndexOf use to compare values, instead of ==.
+// It allows matching structs by a field, or storing values such as slices that == cannot compare.
+func WithEqual(equal EqualFunc) Option {
+	return func(c *config) {
+		c.equal = equal
+	}
+}
+
+// newConfig returns the configuration built from opts.
+func newConfig(opts []Option) config {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
 }
 
 // NewStaticArray creates a new static array with the specified size.
 // The static array is initialized with zero values for each element.
 // The size parameter specifies the number of elements in the array.
 // Returns a pointer to the newly created static array.
-func NewStaticArray(size int) *Array {
+func NewStaticArray(size int, opts ...Option) *Array {
 	return &Array{
 		values:   make([]interface{}, size),
 		isStatic: true,
+		equal:    newConfig(opts).equal,
 	}
 }
 
 // NewDynamicArray creates a new dynamic array.
 // It returns a pointer to an Array struct with isStatic set to false.
-func NewDynamicArray() *Array {
+func NewDynamicArray(opts ...Option) *Array {
 	return &Array{
 		isStatic: false,
+		equal:    newConfig(opts).equal,
 	}
 }
 
@@ -34,23 +74,44 @@ func (a *Array) Push(value interface{}) error {
 		return errors.New("Array capacity is full")
 	}
 	a.values = append(a.values, value)
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Added, Index: len(a.values) - 1, Value: value})
 	return nil
 }
 
 // Pop removes and returns the last element from the array.
 // It modifies the underlying array by reducing its length by 1.
-// Returns the removed element.
+// Returns the removed element. It panics if the array is empty; use TryPop to check.
 func (a *Array) Pop() interface{} {
 	lastElement := a.values[len(a.values)-1]
 	a.values = a.values[:len(a.values)-1]
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Removed, Index: len(a.values), Value: lastElement})
 	return lastElement
 }
 
+// TryPop removes and returns the last element from the array.
+// It returns false if the array is empty, which tells an empty array apart from a stored nil.
+func (a *Array) TryPop() (interface{}, bool) {
+	if len(a.values) == 0 {
+		return nil, false
+	}
+	return a.Pop(), true
+}
+
 // ToArray returns the underlying array as a slice of interfaces.
 func (a *Array) ToArray() []interface{} {
 	return a.values
 }
 
+// Values returns a new slice containing the values of the array.
+// Unlike ToArray, modifying the returned slice does not modify the array.
+func (a *Array) Values() []interface{} {
+	values := make([]interface{}, len(a.values))
+	copy(values, a.values)
+	return values
+}
+
 // Len returns the length of the array.
 func (a *Array) Len() int {
 	return len(a.values)
@@ -65,25 +126,85 @@ func (a *Array) IsEmpty() bool {
 // Contains checks if the array contains the specified value.
 // It returns true if the value is found, otherwise it returns false.
 func (a *Array) Contains(value interface{}) bool {
-	for _, v := range a.values {
-		if v == value {
-			return true
-		}
-	}
-	return false
+	return a.IndexOf(value) != -1
 }
 
 // IndexOf returns the index of the first occurrence of the specified value in the array.
 // If the value is not found, it returns -1.
 func (a *Array) IndexOf(value interface{}) int {
+	return a.IndexFunc(func(v interface{}) bool {
+		return a.equals(v, value)
+	})
+}
+
+// equals compares two values with the equality function of the array.
+func (a *Array) equals(x, y interface{}) bool {
+	if a.equal == nil {
+		return x == y
+	}
+	return a.equal(x, y)
+}
+
+// ContainsFunc checks if the array contains a value for which match returns true.
+func (a *Array) ContainsFunc(match func(value interface{}) bool) bool {
+	return a.IndexFunc(match) != -1
+}
+
+// IndexFunc returns the index of the first value for which match returns true.
+// If there is no such value, it returns -1.
+func (a *Array) IndexFunc(match func(value interface{}) bool) int {
 	for i, v := range a.values {
-		if v == value {
+		if match(v) {
 			return i
 		}
 	}
 	return -1
 }
 
+// RemoveFunc removes the first value for which match returns true.
+// It returns true if a value was removed, otherwise false.
+func (a *Array) RemoveFunc(match func(value interface{}) bool) bool {
+	i := a.IndexFunc(match)
+	if i == -1 {
+		return false
+	}
+	a.RemoveAt(i)
+	return true
+}
+
+// RemoveAllFunc removes every value for which match returns true, keeping the order of the others.
+// It returns the number of removed values.
+func (a *Array) RemoveAllFunc(match func(value interface{}) bool) int {
+	return a.compact(func(i int) bool { return !match(a.values[i]) })
+}
+
+// compact removes every value for which keep returns false, given its index, keeping the order of the others.
+// The removals are delivered to subscribers as a single batch, once the array holds the remaining values.
+// It returns the number of removed values.
+func (a *Array) compact(keep func(i int) bool) int {
+	removed := 0
+	a.observers.Batch(func() {
+		n := 0
+		for i, v := range a.values {
+			if keep(i) {
+				a.values[n] = v
+				n++
+			} else {
+				a.observers.Emit(collections.Event[interface{}]{Kind: collections.Removed, Index: n, Value: v})
+			}
+		}
+		removed = len(a.values) - n
+		if removed > 0 {
+			a.modCount++
+		}
+		for i := n; i < len(a.values); i++ {
+			a.values[i] = nil
+		}
+		a.values = a.values[:n]
+	})
+	return removed
+}
+
 // InsertAt inserts a value at the specified index in the array.
 // It returns an error if the index is out of range.
 // The index should be a non-negative integer less than or equal to the length of the array.
@@ -93,6 +214,8 @@ func (a *Array) InsertAt(index int, value interface{}) error {
 		return errors.New("index out of range")
 	}
 	a.values = append(a.values[:index], append([]interface{}{value}, a.values[index:]...)...)
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Added, Index: index, Value: value})
 	return nil
 }
 
@@ -103,13 +226,21 @@ func (a *Array) RemoveAt(index int) error {
 	if index < 0 || index >= len(a.values) {
 		return errors.New("index out of range")
 	}
+	value := a.values[index]
 	a.values = append(a.values[:index], a.values[index+1:]...)
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Removed, Index: index, Value: value})
 	return nil
 }
 
 // Clear removes all elements from the array.
 func (a *Array) Clear() {
+	if len(a.values) == 0 {
+		return
+	}
 	a.values = a.values[:0]
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Cleared, Index: -1})
 }
 
 // Capacity returns the capacity of the array.
@@ -124,7 +255,19 @@ func (a *Array) Capacity() int {
 func (a *Array) Resize(newSize int) {
 	newSlice := make([]interface{}, newSize)
 	copy(newSlice, a.values)
-	a.values = newSlice
+	// The events are delivered when the batch ends, after the array holds the new values.
+	a.observers.Batch(func() {
+		if a.observers.Active() {
+			for i := len(a.values) - 1; i >= newSize; i-- {
+				a.observers.Emit(collections.Event[interface{}]{Kind: collections.Removed, Index: i, Value: a.values[i]})
+			}
+			for i := len(a.values); i < newSize; i++ {
+				a.observers.Emit(collections.Event[interface{}]{Kind: collections.Added, Index: i})
+			}
+		}
+		a.values = newSlice
+		a.modCount++
+	})
 }
 
 // Get returns the element at the specified index in the array.
@@ -142,6 +285,86 @@ func (a *Array) Set(index int, value interface{}) error {
 	if index < 0 || index >= len(a.values) {
 		return errors.New("index out of range")
 	}
+	old := a.values[index]
 	a.values[index] = value
+	a.modCount++
+	a.observers.Emit(collections.Event[interface{}]{Kind: collections.Updated, Index: index, Value: value, Old: old})
 	return nil
 }
+
+// DuplicateMode selects which elements RemoveDuplicates and DistinctBy remove.
+type DuplicateMode int
+
+const (
+	// Adjacent removes an element only if it equals the element right before it,
+	// which removes every duplicate from a sorted array. RemoveDuplicates compares
+	// the elements with the array's equality function, as set by WithEqual.
+	Adjacent DuplicateMode = iota
+	// KeepFirst removes every element that occurs earlier in the array.
+	// It uses a hash set, so the values must be comparable.
+	KeepFirst
+	// KeepLast removes every element that occurs later in the array.
+	// It uses a hash map, so the values must be comparable.
+	KeepLast
+)
+
+// RemoveDuplicates removes duplicate elements from the array, keeping the order of the remaining ones.
+// By default only adjacent duplicates are removed, which removes every duplicate from a sorted array.
+// Passing KeepFirst or KeepLast removes every duplicate, keeping the first or the last occurrence of each value.
+// Adjacent duplicates are found with the array's equality function, but KeepFirst and KeepLast still hash
+// the values, so they need comparable values even if the array was created with WithEqual.
+func (a *Array) RemoveDuplicates(mode ...DuplicateMode) {
+	a.distinct(a.values, a.equals, mode...)
+}
+
+// DistinctBy removes elements that have the same key, as returned by key, as another element.
+// The mode selects which duplicates are removed, as for RemoveDuplicates; the default is Adjacent.
+// The keys are compared with ==, so they must be comparable in every mode.
+func (a *Array) DistinctBy(key func(value interface{}) interface{}, mode ...DuplicateMode) {
+	keys := make([]interface{}, len(a.values))
+	for i, v := range a.values {
+		keys[i] = key(v)
+	}
+	a.distinct(keys, func(x, y interface{}) bool { return x == y }, mode...)
+}
+
+// distinct removes the elements whose keys are duplicates according to mode. Adjacent keys are compared
+// with equal, while KeepFirst and KeepLast hash the keys.
+func (a *Array) distinct(keys []interface{}, equal func(x, y interface{}) bool, mode ...DuplicateMode) {
+	keep := make([]bool, len(keys))
+	m := Adjacent
+	if len(mode) > 0 {
+		m = mode[0]
+	}
+	switch m {
+	case KeepFirst:
+		seen := make(map[interface{}]struct{})
+		for i, k := range keys {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				keep[i] = true
+			}
+		}
+	case KeepLast:
+		seen := make(map[interface{}]struct{})
+		for i := len(keys) - 1; i >= 0; i-- {
+			if _, ok := seen[keys[i]]; !ok {
+				seen[keys[i]] = struct{}{}
+				keep[i] = true
+			}
+		}
+	default:
+		for i, k := range keys {
+			keep[i] = i == 0 || !equal(k, keys[i-1])
+		}
+	}
+
+	a.compact(func(i int) bool { return keep[i] })
+}
+
+// Subscribe registers fn to be called with an event after every change to the array, and returns
+// a function that unregisters it. Bulk operations such as RemoveAllFunc, RemoveDuplicates and Resize
+// deliver their changes as a single Batch event.
+func (a *Array) Subscribe(fn func(event collections.Event[interface{}])) (unsubscribe func()) {
+	return a.observers.Subscribe(fn)
+}
@@ -1,7 +1,11 @@
 package array
 
 import (
+	"slices"
 	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
 )
 
 func TestInsertAt(t *testing.T) {
@@ -330,6 +334,60 @@ func TestValues(t *testing.T) {
 	}
 }
 
+func TestArrayValues(t *testing.T) {
+	// Test that Values returns a copy of the values of the array
+	arr := NewDynamicArray()
+	arr.InsertAt(0, 10)
+	arr.InsertAt(1, 20)
+	arr.InsertAt(2, 30)
+
+	values := arr.Values()
+	if len(values) != 3 || values[0] != 10 || values[1] != 20 || values[2] != 30 {
+		t.Errorf("Expected [10 20 30], got %v", values)
+	}
+
+	// Test that modifying the returned slice does not modify the array
+	values[0] = 40
+	if v, _ := arr.Get(0); v != 10 {
+		t.Errorf("Expected value at index 0 to still be 10, got %v", v)
+	}
+
+	// Test that modifying the array does not modify the returned slice
+	arr.Set(1, 50)
+	if values[1] != 20 {
+		t.Errorf("Expected the returned slice to still hold 20, got %v", values[1])
+	}
+}
+
+func TestPop(t *testing.T) {
+	// Test that Pop removes the last element, and panics on an empty array
+	arr := NewDynamicArray()
+	arr.Push(1)
+	arr.Push(2)
+	if v := arr.Pop(); v != 2 || arr.Len() != 1 {
+		t.Errorf("Expected 2 with 1 element left, got %v with %d", v, arr.Len())
+	}
+	arr.Pop()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Pop on an empty array to panic")
+		}
+	}()
+	arr.Pop()
+}
+
+func TestTryPop(t *testing.T) {
+	// Test that TryPop tells a stored nil apart from an empty array
+	arr := NewDynamicArray()
+	arr.Push(nil)
+	if v, ok := arr.TryPop(); !ok || v != nil {
+		t.Errorf("Expected nil, true, got %v, %v", v, ok)
+	}
+	if v, ok := arr.TryPop(); ok || v != nil {
+		t.Errorf("Expected nil, false on an empty array, got %v, %v", v, ok)
+	}
+}
+
 func TestNewDynamicArray(t *testing.T) {
 
 	// Create a new instance of the Array struct
@@ -340,3 +398,429 @@ func TestNewDynamicArray(t *testing.T) {
 		t.Errorf("Expected Values to return an array of length 0, got %v", len(arr.ToArray()))
 	}
 }
+
+func TestRemoveDuplicates(t *testing.T) {
+	// Test each mode on the same values
+	tests := []struct {
+		mode     []DuplicateMode
+		expected []interface{}
+	}{
+		{nil, []interface{}{1, 2, 1, 3, 2}},
+		{[]DuplicateMode{Adjacent}, []interface{}{1, 2, 1, 3, 2}},
+		{[]DuplicateMode{KeepFirst}, []interface{}{1, 2, 3}},
+		{[]DuplicateMode{KeepLast}, []interface{}{1, 3, 2}},
+	}
+	for _, test := range tests {
+		arr := NewDynamicArray()
+		for _, v := range []int{1, 1, 2, 1, 3, 3, 2} {
+			arr.Push(v)
+		}
+		arr.RemoveDuplicates(test.mode...)
+		if arr.Len() != len(test.expected) {
+			t.Errorf("Expected %v with mode %v, got %v", test.expected, test.mode, arr.ToArray())
+			continue
+		}
+		for i, v := range arr.ToArray() {
+			if v != test.expected[i] {
+				t.Errorf("Expected element at index %d to be %v with mode %v, got %v", i, test.expected[i], test.mode, v)
+			}
+		}
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	// Create a new array of words
+	arr := NewDynamicArray()
+	for _, w := range []string{"apple", "avocado", "banana", "blueberry", "cherry", "apricot"} {
+		arr.Push(w)
+	}
+
+	// Test keeping the first word for each initial
+	arr.DistinctBy(func(value interface{}) interface{} { return value.(string)[0] }, KeepFirst)
+	expected := []interface{}{"apple", "banana", "cherry"}
+	if arr.Len() != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, arr.ToArray())
+	}
+	for i, v := range arr.ToArray() {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+}
+
+func TestWithEqual(t *testing.T) {
+	// Create a new array that compares slices by their first element
+	arr := NewDynamicArray(WithEqual(func(a, b interface{}) bool {
+		return a.([]int)[0] == b.([]int)[0]
+	}))
+	arr.Push([]int{1, 2})
+	arr.Push([]int{3, 4})
+
+	// Test Contains and IndexOf with values that == cannot compare
+	if !arr.Contains([]int{3}) {
+		t.Errorf("Expected array to contain [3]")
+	}
+	if arr.IndexOf([]int{3, 0}) != 1 {
+		t.Errorf("Expected index to be 1, got %d", arr.IndexOf([]int{3, 0}))
+	}
+	if arr.IndexOf([]int{5}) != -1 {
+		t.Errorf("Expected index to be -1, got %d", arr.IndexOf([]int{5}))
+	}
+
+	// Test removing adjacent duplicates with the equality function
+	dup := NewDynamicArray(WithEqual(func(a, b interface{}) bool {
+		return a.([]int)[0] == b.([]int)[0]
+	}))
+	for _, v := range [][]int{{1, 2}, {1, 3}, {2}, {1}, {1, 4}} {
+		dup.Push(v)
+	}
+	dup.RemoveDuplicates()
+	values := dup.ToArray()
+	if len(values) != 3 || values[0].([]int)[1] != 2 || values[1].([]int)[0] != 2 || values[2].([]int)[0] != 1 {
+		t.Errorf("Expected [[1 2] [2] [1]], got %v", values)
+	}
+
+	// Test a static array with an equality function
+	static := NewStaticArray(2, WithEqual(func(a, b interface{}) bool { return a == nil && b == nil }))
+	if !static.Contains(nil) {
+		t.Errorf("Expected static array to contain nil")
+	}
+}
+
+func TestFuncs(t *testing.T) {
+	// Create a new array
+	arr := NewDynamicArray()
+	for i := 1; i <= 6; i++ {
+		arr.Push(i)
+	}
+	even := func(value interface{}) bool { return value.(int)%2 == 0 }
+
+	// Test ContainsFunc and IndexFunc
+	if !arr.ContainsFunc(even) || arr.IndexFunc(even) != 1 {
+		t.Errorf("Expected the first even value at index 1, got %d", arr.IndexFunc(even))
+	}
+
+	// Test RemoveFunc and RemoveAllFunc
+	if !arr.RemoveFunc(even) || arr.Contains(2) {
+		t.Errorf("Expected 2 to be removed")
+	}
+	if removed := arr.RemoveAllFunc(even); removed != 2 {
+		t.Errorf("Expected 2 values to be removed, got %d", removed)
+	}
+	if arr.ContainsFunc(even) || arr.RemoveFunc(even) || arr.Len() != 3 {
+		t.Errorf("Expected [1 3 5], got %v", arr.ToArray())
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	// Test that replaying the events of every change reproduces the array
+	arr := NewDynamicArray()
+	var replayed []interface{}
+	notifications := 0
+	unsubscribe := arr.Subscribe(func(event collections.Event[interface{}]) {
+		replayed = testkit.Replay(replayed, event)
+		notifications++
+	})
+	check := func(operation string) {
+		t.Helper()
+		if !slices.Equal(replayed, arr.Values()) {
+			t.Errorf("Expected events to reproduce %v after %s, got %v", arr.Values(), operation, replayed)
+		}
+	}
+
+	arr.Push(1)
+	arr.Push(2)
+	arr.Push(1)
+	check("Push")
+	arr.InsertAt(1, 3)
+	check("InsertAt")
+	arr.Set(0, 4)
+	check("Set")
+	arr.RemoveAt(2)
+	check("RemoveAt")
+	arr.Pop()
+	check("Pop")
+	arr.Resize(5)
+	check("Resize")
+	arr.Resize(1)
+	check("Resize")
+
+	// Test that a bulk operation is delivered as a single notification
+	arr.Push(2)
+	arr.Push(2)
+	arr.Push(3)
+	notifications = 0
+	if removed := arr.RemoveAllFunc(func(v interface{}) bool { return v == 2 }); removed != 2 {
+		t.Errorf("Expected 2 removed elements, got %d", removed)
+	}
+	if notifications != 1 {
+		t.Errorf("Expected 1 notification for RemoveAllFunc, got %d", notifications)
+	}
+	check("RemoveAllFunc")
+	arr.Clear()
+	check("Clear")
+
+	// Test that no events are delivered after unsubscribing
+	unsubscribe()
+	notifications = 0
+	arr.Push(1)
+	if notifications != 0 {
+		t.Errorf("Expected no notifications after unsubscribing, got %d", notifications)
+	}
+}
+
+func TestSubscribeSeesFinalState(t *testing.T) {
+	// Test that a subscriber reading the array during a notification sees it after the whole change
+	tests := []struct {
+		name     string
+		apply    func(arr *Array)
+		expected []interface{}
+	}{
+		{"RemoveDuplicates", func(arr *Array) { arr.RemoveDuplicates(KeepFirst) }, []interface{}{1, 2, 3}},
+		{"RemoveAllFunc", func(arr *Array) { arr.RemoveAllFunc(func(v interface{}) bool { return v == 2 }) }, []interface{}{1, 1, 3}},
+		{"Resize smaller", func(arr *Array) { arr.Resize(1) }, []interface{}{1}},
+		{"Resize larger", func(arr *Array) { arr.Resize(6) }, []interface{}{1, 2, 1, 3, 2, nil}},
+	}
+	for _, test := range tests {
+		arr := NewDynamicArray()
+		for _, v := range []interface{}{1, 2, 1, 3, 2} {
+			arr.Push(v)
+		}
+		var seen []interface{}
+		seenLen := -1
+		arr.Subscribe(func(collections.Event[interface{}]) {
+			seen = arr.Values()
+			seenLen = arr.Len()
+		})
+		test.apply(arr)
+		if !slices.Equal(seen, test.expected) || seenLen != len(test.expected) {
+			t.Errorf("Expected the subscriber to see %v after %s, got %v with Len %d", test.expected, test.name, seen, seenLen)
+		}
+	}
+}
+
+func TestArrayConformance(t *testing.T) {
+	// Test that Array behaves like every other List
+	testkit.TestList(t, func() collections.List[interface{}] { return NewDynamicArray() },
+		func(i int) interface{} { return i })
+
+	// Test that Contains and IndexOf use the equality function of the array
+	testkit.TestList(t, func() collections.List[interface{}] {
+		return NewDynamicArray(WithEqual(func(a, b interface{}) bool { return a.(int)%100 == b.(int)%100 }))
+	}, func(i int) interface{} { return i }, testkit.WithEquivalent(func(i int) interface{} { return i + 100 }))
+}
+
+func FuzzArray(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 1, 3, 2, 1, 3, 0, 4, 1})
+	f.Add([]byte{0, 1, 5, 0, 0, 2, 6, 2, 0, 3})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to an Array and to a slice, and compare them, and the changes replayed
+		// from the events of the array, after every operation
+		arr := NewDynamicArray()
+		var model, replayed []interface{}
+		arr.Subscribe(func(event collections.Event[interface{}]) { replayed = testkit.Replay(replayed, event) })
+		for _, op := range testkit.DecodeOps(data, 7) {
+			index := op.Arg % (len(model) + 2)
+			inRange := index >= 0 && index < len(model)
+			switch op.Code {
+			case 0:
+				if err := arr.Push(op.Arg); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				model = append(model, op.Arg)
+			case 1:
+				err := arr.InsertAt(index, op.Arg)
+				if (err == nil) != (index >= 0 && index <= len(model)) {
+					t.Fatalf("Expected InsertAt(%d) on %v to fail: %v, got %v", index, model, err == nil, err)
+				}
+				if err == nil {
+					model = slices.Insert(model, index, interface{}(op.Arg))
+				}
+			case 2:
+				if err := arr.RemoveAt(index); (err == nil) != inRange {
+					t.Fatalf("Expected RemoveAt(%d) on %v to fail: %v, got %v", index, model, !inRange, err)
+				}
+				if inRange {
+					model = slices.Delete(model, index, index+1)
+				}
+			case 3:
+				v, err := arr.Get(index)
+				if (err == nil) != inRange || inRange && v != model[index] {
+					t.Fatalf("Expected Get(%d) on %v to match, got %v (%v)", index, model, v, err)
+				}
+			case 4:
+				if err := arr.Set(index, op.Arg); (err == nil) != inRange {
+					t.Fatalf("Expected Set(%d) on %v to fail: %v, got %v", index, model, !inRange, err)
+				}
+				if inRange {
+					model[index] = op.Arg
+				}
+			case 5:
+				v, ok := arr.TryPop()
+				if ok != (len(model) > 0) || ok && v != model[len(model)-1] {
+					t.Fatalf("Expected TryPop on %v to match, got %v, %v", model, v, ok)
+				}
+				if ok {
+					model = model[:len(model)-1]
+				}
+			case 6:
+				if got := arr.IndexOf(op.Arg); got != slices.Index(model, interface{}(op.Arg)) {
+					t.Fatalf("Expected IndexOf(%d) on %v to be %d, got %d", op.Arg, model, slices.Index(model, interface{}(op.Arg)), got)
+				}
+			}
+			if !slices.Equal(arr.Values(), model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, arr.Values())
+			}
+			if !slices.Equal(replayed, model) {
+				t.Fatalf("Expected events to reproduce %v after %+v, got %v", model, op, replayed)
+			}
+		}
+	})
+}
+
+// benchSink keeps the compiler from optimizing away the results of benchmarked operations.
+var benchSink interface{}
+
+// newBenchArray returns a dynamic array holding the integers from 0 to n-1.
+func newBenchArray(n int) *Array {
+	arr := NewDynamicArray()
+	for i := 0; i < n; i++ {
+		arr.Push(i)
+	}
+	return arr
+}
+
+// newBenchSlice returns a slice holding the integers from 0 to n-1.
+func newBenchSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func BenchmarkArrayGet(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink, _ = arr.Get(i % n)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s[i%n]
+		}
+	})
+}
+
+func BenchmarkArraySet(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			arr.Set(i%n, i)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s[i%n] = i
+		}
+	})
+}
+
+func BenchmarkArrayPushPop(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			arr.Push(i)
+			benchSink = arr.Pop()
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = append(s, i)
+			benchSink = s[len(s)-1]
+			s = s[:len(s)-1]
+		}
+	})
+}
+
+func BenchmarkArrayInsertRemoveFront(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			arr.InsertAt(0, i)
+			arr.RemoveAt(0)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = slices.Insert(s, 0, i)
+			s = slices.Delete(s, 0, 1)
+		}
+	})
+}
+
+func BenchmarkArrayInsertRemoveMiddle(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			arr.InsertAt(n/2, i)
+			arr.RemoveAt(n / 2)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = slices.Insert(s, n/2, i)
+			s = slices.Delete(s, n/2, n/2+1)
+		}
+	})
+}
+
+func BenchmarkArrayContains(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = arr.Contains(n / 2)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = slices.Contains(s, n/2)
+		}
+	})
+}
+
+func BenchmarkArrayValues(b *testing.B) {
+	testkit.Bench(b, "Array", func(b *testing.B, n int) {
+		arr := newBenchArray(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = arr.Values()
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = slices.Clone(s)
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package array
+
+import "errors"
+
+// NDArray represents a fixed-shape multi-dimensional array.
+// Elements are stored in row-major order. Views returned by Transpose and View
+// share storage with the array they were taken from.
+type NDArray struct {
+	values  []interface{} // The underlying storage shared between views.
+	shape   []int         // The length of each dimension.
+	strides []int         // The distance in values between consecutive indices of each dimension.
+	offset  int           // The position in values of the first element.
+}
+
+// NewNDArray creates a new multi-dimensional array with the specified shape.
+// The array is initialized with zero values for each element.
+// It returns an error if no dimensions are given or any dimension is not positive.
+func NewNDArray(shape ...int) (*NDArray, error) {
+	size, err := shapeSize(shape)
+	if err != nil {
+		return nil, err
+	}
+	return &NDArray{
+		values:  make([]interface{}, size),
+		shape:   append([]int(nil), shape...),
+		strides: rowMajorStrides(shape),
+	}, nil
+}
+
+// NewMatrix creates a new two-dimensional array with the specified number of rows and columns.
+func NewMatrix(rows, columns int) (*NDArray, error) {
+	return NewNDArray(rows, columns)
+}
+
+// shapeSize returns the number of elements described by shape.
+func shapeSize(shape []int) (int, error) {
+	if len(shape) == 0 {
+		return 0, errors.New("invalid shape")
+	}
+	size := 1
+	for _, dim := range shape {
+		if dim <= 0 {
+			return 0, errors.New("invalid shape")
+		}
+		size *= dim
+	}
+	return size, nil
+}
+
+// rowMajorStrides returns the strides of a contiguous row-major array with the given shape.
+func rowMajorStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	stride := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= shape[i]
+	}
+	return strides
+}
+
+// Shape returns the length of each dimension of the array.
+func (a *NDArray) Shape() []int {
+	return append([]int(nil), a.shape...)
+}
+
+// Dims returns the number of dimensions of the array.
+func (a *NDArray) Dims() int {
+	return len(a.shape)
+}
+
+// Len returns the total number of elements in the array.
+func (a *NDArray) Len() int {
+	size, _ := shapeSize(a.shape)
+	return size
+}
+
+// position returns the position in the underlying storage of the element at indices.
+// It returns an error if the number of indices does not match the number of dimensions
+// or any index is out of range.
+func (a *NDArray) position(indices []int) (int, error) {
+	if len(indices) != len(a.shape) {
+		return 0, errors.New("wrong number of indices")
+	}
+	pos := a.offset
+	for i, index := range indices {
+		if index < 0 || index >= a.shape[i] {
+			return 0, errors.New("index out of range")
+		}
+		pos += index * a.strides[i]
+	}
+	return pos, nil
+}
+
+// At returns the element at the specified indices.
+// It returns an error if the indices are out of range.
+func (a *NDArray) At(indices ...int) (interface{}, error) {
+	pos, err := a.position(indices)
+	if err != nil {
+		return nil, err
+	}
+	return a.values[pos], nil
+}
+
+// SetAt sets the element at the specified indices to value.
+// It returns an error if the indices are out of range.
+func (a *NDArray) SetAt(value interface{}, indices ...int) error {
+	pos, err := a.position(indices)
+	if err != nil {
+		return err
+	}
+	a.values[pos] = value
+	return nil
+}
+
+// Fill sets every element of the array to value.
+func (a *NDArray) Fill(value interface{}) {
+	a.each(func(pos int) {
+		a.values[pos] = value
+	})
+}
+
+// each calls fn with the storage position of every element, in row-major order.
+func (a *NDArray) each(fn func(pos int)) {
+	indices := make([]int, len(a.shape))
+	for n := a.Len(); n > 0; n-- {
+		pos := a.offset
+		for i, index := range indices {
+			pos += index * a.strides[i]
+		}
+		fn(pos)
+		for i := len(indices) - 1; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < a.shape[i] {
+				break
+			}
+			indices[i] = 0
+		}
+	}
+}
+
+// isContiguous checks if the elements of the array are laid out in row-major order
+// without gaps in the underlying storage.
+func (a *NDArray) isContiguous() bool {
+	stride := 1
+	for i := len(a.shape) - 1; i >= 0; i-- {
+		if a.shape[i] != 1 && a.strides[i] != stride {
+			return false
+		}
+		stride *= a.shape[i]
+	}
+	return true
+}
+
+// ToArray returns the elements of the array as a slice in row-major order.
+// The returned slice is a copy and does not share storage with the array.
+func (a *NDArray) ToArray() []interface{} {
+	values := make([]interface{}, 0, a.Len())
+	a.each(func(pos int) {
+		values = append(values, a.values[pos])
+	})
+	return values
+}
+
+// Flatten returns a static Array containing the elements of the array in row-major order.
+func (a *NDArray) Flatten() *Array {
+	return &Array{
+		values:   a.ToArray(),
+		isStatic: true,
+	}
+}
+
+// Clone returns a contiguous copy of the array that does not share storage with it.
+func (a *NDArray) Clone() *NDArray {
+	return &NDArray{
+		values:  a.ToArray(),
+		shape:   a.Shape(),
+		strides: rowMajorStrides(a.shape),
+	}
+}
+
+// Reshape returns an array with the same elements in row-major order and the specified shape.
+// The result shares storage with the array if its elements are contiguous, otherwise it is a copy.
+// It returns an error if the new shape does not hold the same number of elements.
+func (a *NDArray) Reshape(shape ...int) (*NDArray, error) {
+	size, err := shapeSize(shape)
+	if err != nil {
+		return nil, err
+	}
+	if size != a.Len() {
+		return nil, errors.New("shape mismatch")
+	}
+	source := a
+	if !a.isContiguous() {
+		source = a.Clone()
+	}
+	return &NDArray{
+		values:  source.values,
+		shape:   append([]int(nil), shape...),
+		strides: rowMajorStrides(shape),
+		offset:  source.offset,
+	}, nil
+}
+
+// Transpose returns a view of the array with the order of its dimensions reversed.
+// For a matrix, the element at (i, j) of the result is the element at (j, i) of the array.
+func (a *NDArray) Transpose() *NDArray {
+	n := len(a.shape)
+	shape := make([]int, n)
+	strides := make([]int, n)
+	for i := 0; i < n; i++ {
+		shape[i] = a.shape[n-1-i]
+		strides[i] = a.strides[n-1-i]
+	}
+	return &NDArray{
+		values:  a.values,
+		shape:   shape,
+		strides: strides,
+		offset:  a.offset,
+	}
+}
+
+// View returns a view of the sub-region of the array between from (inclusive) and to (exclusive)
+// along every dimension. Changes made through the view are visible in the array and vice versa.
+// It returns an error if the bounds do not match the number of dimensions or are out of range.
+func (a *NDArray) View(from, to []int) (*NDArray, error) {
+	if len(from) != len(a.shape) || len(to) != len(a.shape) {
+		return nil, errors.New("wrong number of indices")
+	}
+	shape := make([]int, len(a.shape))
+	offset := a.offset
+	for i := range a.shape {
+		if from[i] < 0 || to[i] > a.shape[i] || from[i] >= to[i] {
+			return nil, errors.New("index out of range")
+		}
+		shape[i] = to[i] - from[i]
+		offset += from[i] * a.strides[i]
+	}
+	return &NDArray{
+		values:  a.values,
+		shape:   shape,
+		strides: append([]int(nil), a.strides...),
+		offset:  offset,
+	}, nil
+}
+
+// Row returns a copy of the elements in row i of a two-dimensional array.
+// It returns an error if the array is not two-dimensional or the index is out of range.
+func (a *NDArray) Row(i int) ([]interface{}, error) {
+	if len(a.shape) != 2 {
+		return nil, errors.New("array is not two-dimensional")
+	}
+	if i < 0 || i >= a.shape[0] {
+		return nil, errors.New("index out of range")
+	}
+	row := make([]interface{}, a.shape[1])
+	for j := range row {
+		row[j] = a.values[a.offset+i*a.strides[0]+j*a.strides[1]]
+	}
+	return row, nil
+}
+
+// Column returns a copy of the elements in column j of a two-dimensional array.
+// It returns an error if the array is not two-dimensional or the index is out of range.
+func (a *NDArray) Column(j int) ([]interface{}, error) {
+	if len(a.shape) != 2 {
+		return nil, errors.New("array is not two-dimensional")
+	}
+	return a.Transpose().Row(j)
+}
+
+// ForEachRow calls fn with the index and a copy of each row of a two-dimensional array, in order.
+// It returns an error if the array is not two-dimensional.
+func (a *NDArray) ForEachRow(fn func(i int, row []interface{})) error {
+	if len(a.shape) != 2 {
+		return errors.New("array is not two-dimensional")
+	}
+	for i := 0; i < a.shape[0]; i++ {
+		row, _ := a.Row(i)
+		fn(i, row)
+	}
+	return nil
+}
+
+// ForEachColumn calls fn with the index and a copy of each column of a two-dimensional array, in order.
+// It returns an error if the array is not two-dimensional.
+func (a *NDArray) ForEachColumn(fn func(j int, column []interface{})) error {
+	if len(a.shape) != 2 {
+		return errors.New("array is not two-dimensional")
+	}
+	return a.Transpose().ForEachRow(fn)
+}
@@ -0,0 +1,236 @@
+package array
+
+import (
+	"testing"
+)
+
+func TestNewNDArray(t *testing.T) {
+	// Test creating an array with a valid shape
+	arr, err := NewNDArray(2, 3, 4)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if arr.Dims() != 3 {
+		t.Errorf("Expected 3 dimensions, got %d", arr.Dims())
+	}
+	if arr.Len() != 24 {
+		t.Errorf("Expected 24 elements, got %d", arr.Len())
+	}
+
+	// Test creating an array with an invalid shape
+	if _, err := NewNDArray(2, 0); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if _, err := NewNDArray(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestNDArrayAtSetAt(t *testing.T) {
+	// Create a new 2x3 matrix
+	m, _ := NewMatrix(2, 3)
+
+	// Test setting and getting values
+	err := m.SetAt(10, 1, 2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if val, _ := m.At(1, 2); val != 10 {
+		t.Errorf("Expected value at (1, 2) to be 10, got %v", val)
+	}
+
+	// Test row-major storage
+	if val := m.ToArray()[5]; val != 10 {
+		t.Errorf("Expected value at position 5 to be 10, got %v", val)
+	}
+
+	// Test indices out of range
+	_, err = m.At(2, 0)
+	if err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if err.Error() != "index out of range" {
+		t.Errorf("Expected error message 'index out of range', got %v", err.Error())
+	}
+	if err := m.SetAt(1, 0, -1); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test wrong number of indices
+	if _, err := m.At(1); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestNDArrayReshape(t *testing.T) {
+	// Create a new 2x3 matrix with values 0..5
+	m, _ := NewMatrix(2, 3)
+	for i := 0; i < 6; i++ {
+		m.SetAt(i, i/3, i%3)
+	}
+
+	// Test reshaping into 3x2
+	r, err := m.Reshape(3, 2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if val, _ := r.At(2, 1); val != 5 {
+		t.Errorf("Expected value at (2, 1) to be 5, got %v", val)
+	}
+
+	// Test that the reshaped array shares storage
+	r.SetAt(50, 2, 1)
+	if val, _ := m.At(1, 2); val != 50 {
+		t.Errorf("Expected value at (1, 2) to be 50, got %v", val)
+	}
+
+	// Test reshaping into a mismatched shape
+	if _, err := m.Reshape(4, 2); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test reshaping a transposed view copies in row-major order
+	tr, _ := m.Transpose().Reshape(6)
+	expected := []interface{}{0, 3, 1, 4, 2, 50}
+	for i, v := range tr.ToArray() {
+		if v != expected[i] {
+			t.Errorf("Expected value at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+}
+
+func TestNDArrayTranspose(t *testing.T) {
+	// Create a new 2x3 matrix
+	m, _ := NewMatrix(2, 3)
+	m.SetAt(7, 0, 2)
+
+	// Test the transposed shape and values
+	tr := m.Transpose()
+	if shape := tr.Shape(); shape[0] != 3 || shape[1] != 2 {
+		t.Errorf("Expected shape [3 2], got %v", shape)
+	}
+	if val, _ := tr.At(2, 0); val != 7 {
+		t.Errorf("Expected value at (2, 0) to be 7, got %v", val)
+	}
+
+	// Test that the transpose is a view
+	tr.SetAt(8, 1, 1)
+	if val, _ := m.At(1, 1); val != 8 {
+		t.Errorf("Expected value at (1, 1) to be 8, got %v", val)
+	}
+}
+
+func TestNDArrayRowsAndColumns(t *testing.T) {
+	// Create a new 2x3 matrix with values 0..5
+	m, _ := NewMatrix(2, 3)
+	for i := 0; i < 6; i++ {
+		m.SetAt(i, i/3, i%3)
+	}
+
+	// Test Row
+	row, err := m.Row(1)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(row) != 3 || row[0] != 3 || row[2] != 5 {
+		t.Errorf("Expected row 1 to be [3 4 5], got %v", row)
+	}
+
+	// Test Column
+	column, _ := m.Column(2)
+	if len(column) != 2 || column[0] != 2 || column[1] != 5 {
+		t.Errorf("Expected column 2 to be [2 5], got %v", column)
+	}
+
+	// Test out of range
+	if _, err := m.Row(2); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test ForEachRow and ForEachColumn
+	rows := 0
+	m.ForEachRow(func(i int, row []interface{}) {
+		if row[0] != i*3 {
+			t.Errorf("Expected row %d to start with %d, got %v", i, i*3, row[0])
+		}
+		rows++
+	})
+	if rows != 2 {
+		t.Errorf("Expected 2 rows, got %d", rows)
+	}
+	columns := 0
+	m.ForEachColumn(func(j int, column []interface{}) {
+		columns++
+	})
+	if columns != 3 {
+		t.Errorf("Expected 3 columns, got %d", columns)
+	}
+
+	// Test a non-matrix
+	cube, _ := NewNDArray(2, 2, 2)
+	if _, err := cube.Row(0); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestNDArrayView(t *testing.T) {
+	// Create a new 4x4 matrix with values 0..15
+	m, _ := NewMatrix(4, 4)
+	for i := 0; i < 16; i++ {
+		m.SetAt(i, i/4, i%4)
+	}
+
+	// Test the center 2x2 region
+	v, err := m.View([]int{1, 1}, []int{3, 3})
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expected := []interface{}{5, 6, 9, 10}
+	for i, val := range v.ToArray() {
+		if val != expected[i] {
+			t.Errorf("Expected value at index %d to be %v, got %v", i, expected[i], val)
+		}
+	}
+
+	// Test that the view shares storage
+	v.Fill(0)
+	if val, _ := m.At(2, 2); val != 0 {
+		t.Errorf("Expected value at (2, 2) to be 0, got %v", val)
+	}
+	if val, _ := m.At(0, 0); val != 0 {
+		t.Errorf("Expected value at (0, 0) to be 0, got %v", val)
+	}
+	if val, _ := m.At(3, 3); val != 15 {
+		t.Errorf("Expected value at (3, 3) to be 15, got %v", val)
+	}
+
+	// Test invalid regions
+	if _, err := m.View([]int{1, 1}, []int{5, 3}); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if _, err := m.View([]int{2, 2}, []int{2, 3}); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestNDArrayCloneAndFlatten(t *testing.T) {
+	// Create a new 2x2 matrix
+	m, _ := NewMatrix(2, 2)
+	m.SetAt(1, 0, 0)
+
+	// Test that the clone is independent
+	c := m.Clone()
+	c.SetAt(2, 0, 0)
+	if val, _ := m.At(0, 0); val != 1 {
+		t.Errorf("Expected value at (0, 0) to be 1, got %v", val)
+	}
+
+	// Test Flatten
+	flat := m.Flatten()
+	if flat.Len() != 4 {
+		t.Errorf("Expected flattened length to be 4, got %d", flat.Len())
+	}
+	if err := flat.Push(5); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
@@ -0,0 +1,187 @@
+package array
+
+import (
+	"errors"
+
+	"goCollections/collections"
+)
+
+// Tx is a transaction on an Array, created by Begin. It has the same methods as the array for reading
+// and changing it, which work on a copy: reads see the changes of the transaction, and the array is
+// unchanged until Commit.
+// A transaction holds no resources of the array, so one that is never finished is simply garbage collected.
+type Tx struct {
+	array    *Array
+	working  *Array                           // The copy of the array that the transaction changes.
+	events   []collections.Event[interface{}] // The changes made to working, delivered to the subscribers of array on Commit.
+	modCount int                              // The modification count of the array when the transaction began.
+	done     bool                             // Whether Commit or Rollback was called.
+}
+
+var _ collections.List[interface{}] = (*Tx)(nil)
+
+// cloneValues returns a copy of values with the same capacity, so that a static array stays full.
+func cloneValues(values []interface{}) []interface{} {
+	clone := make([]interface{}, len(values), cap(values))
+	copy(clone, values)
+	return clone
+}
+
+// Begin starts a transaction on the array. It copies the array, so it takes linear time.
+// The array must not be changed while the transaction is open, or Commit fails. Changes are detected with
+// a modification count, so the transaction does not subscribe to the array.
+func (a *Array) Begin() *Tx {
+	tx := &Tx{
+		array:    a,
+		working:  &Array{values: cloneValues(a.values), isStatic: a.isStatic, equal: a.equal},
+		modCount: a.modCount,
+	}
+	tx.working.Subscribe(func(event collections.Event[interface{}]) {
+		tx.events = append(tx.events, event)
+	})
+	return tx
+}
+
+// finish ends the transaction.
+func (tx *Tx) finish() error {
+	if tx.done {
+		return errors.New("transaction is already finished")
+	}
+	tx.done = true
+	return nil
+}
+
+// Commit applies the changes of the transaction to the array, and delivers them to its subscribers
+// as a single notification. It returns an error, and leaves the array unchanged, if the array was
+// changed outside of the transaction or the transaction is already finished.
+func (tx *Tx) Commit() error {
+	if err := tx.finish(); err != nil {
+		return err
+	}
+	a := tx.array
+	if a.modCount != tx.modCount {
+		return errors.New("array was modified outside of the transaction")
+	}
+	a.values = cloneValues(tx.working.values)
+	if len(tx.events) > 0 {
+		a.modCount++
+	}
+	a.observers.Batch(func() {
+		for _, event := range tx.events {
+			a.observers.Emit(event)
+		}
+	})
+	tx.events = nil
+	return nil
+}
+
+// Rollback discards the changes of the transaction. It returns an error if the transaction is already finished.
+func (tx *Tx) Rollback() error {
+	if err := tx.finish(); err != nil {
+		return err
+	}
+	tx.events = nil
+	return nil
+}
+
+// Push adds a new element to the end of the array in the transaction.
+func (tx *Tx) Push(value interface{}) error {
+	return tx.working.Push(value)
+}
+
+// Pop removes and returns the last element of the array in the transaction. It panics if it is empty.
+func (tx *Tx) Pop() interface{} {
+	return tx.working.Pop()
+}
+
+// TryPop removes and returns the last element of the array in the transaction.
+// It returns false if it is empty.
+func (tx *Tx) TryPop() (interface{}, bool) {
+	return tx.working.TryPop()
+}
+
+// InsertAt inserts a value at the specified index of the array in the transaction.
+func (tx *Tx) InsertAt(index int, value interface{}) error {
+	return tx.working.InsertAt(index, value)
+}
+
+// RemoveAt removes the element at the specified index of the array in the transaction.
+func (tx *Tx) RemoveAt(index int) error {
+	return tx.working.RemoveAt(index)
+}
+
+// RemoveFunc removes the first element for which match returns true from the array in the transaction.
+func (tx *Tx) RemoveFunc(match func(value interface{}) bool) bool {
+	return tx.working.RemoveFunc(match)
+}
+
+// RemoveAllFunc removes every element for which match returns true from the array in the transaction.
+func (tx *Tx) RemoveAllFunc(match func(value interface{}) bool) int {
+	return tx.working.RemoveAllFunc(match)
+}
+
+// RemoveDuplicates removes duplicate elements from the array in the transaction.
+func (tx *Tx) RemoveDuplicates(mode ...DuplicateMode) {
+	tx.working.RemoveDuplicates(mode...)
+}
+
+// DistinctBy removes elements with the same key from the array in the transaction.
+func (tx *Tx) DistinctBy(key func(value interface{}) interface{}, mode ...DuplicateMode) {
+	tx.working.DistinctBy(key, mode...)
+}
+
+// Set sets the value at the specified index of the array in the transaction.
+func (tx *Tx) Set(index int, value interface{}) error {
+	return tx.working.Set(index, value)
+}
+
+// Resize resizes the array in the transaction.
+func (tx *Tx) Resize(newSize int) {
+	tx.working.Resize(newSize)
+}
+
+// Clear removes all elements from the array in the transaction.
+func (tx *Tx) Clear() {
+	tx.working.Clear()
+}
+
+// Get returns the element at the specified index of the array in the transaction.
+func (tx *Tx) Get(index int) (interface{}, error) {
+	return tx.working.Get(index)
+}
+
+// Len returns the number of elements of the array in the transaction.
+func (tx *Tx) Len() int {
+	return tx.working.Len()
+}
+
+// IsEmpty checks if the array in the transaction is empty.
+func (tx *Tx) IsEmpty() bool {
+	return tx.working.IsEmpty()
+}
+
+// Values returns a new slice containing the values of the array in the transaction.
+func (tx *Tx) Values() []interface{} {
+	return tx.working.Values()
+}
+
+// Contains checks if the array in the transaction contains the specified value.
+func (tx *Tx) Contains(value interface{}) bool {
+	return tx.working.Contains(value)
+}
+
+// IndexOf returns the index of the first occurrence of the value in the array in the transaction, or -1.
+func (tx *Tx) IndexOf(value interface{}) int {
+	return tx.working.IndexOf(value)
+}
+
+// ContainsFunc checks if the array in the transaction contains a value for which match returns true.
+func (tx *Tx) ContainsFunc(match func(value interface{}) bool) bool {
+	return tx.working.ContainsFunc(match)
+}
+
+// IndexFunc returns the index of the first value in the array in the transaction for which match
+// returns true, or -1.
+func (tx *Tx) IndexFunc(match func(value interface{}) bool) int {
+	return tx.working.IndexFunc(match)
+}
@@ -0,0 +1,140 @@
+package array
+
+import (
+	"slices"
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestTxCommit(t *testing.T) {
+	// Test that the array is unchanged until commit, while the transaction sees its own changes
+	arr := NewDynamicArray()
+	arr.Push(1)
+	arr.Push(2)
+	tx := arr.Begin()
+	tx.Push(3)
+	tx.Set(0, 4)
+	tx.RemoveAt(1)
+	if v, _ := tx.Get(0); v != 4 || tx.Len() != 2 {
+		t.Errorf("Expected the transaction to hold [4 3], got %v", tx.Values())
+	}
+	if !slices.Equal(arr.Values(), []interface{}{1, 2}) {
+		t.Errorf("Expected the array to be unchanged before commit, got %v", arr.Values())
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(arr.Values(), []interface{}{4, 3}) {
+		t.Errorf("Expected [4 3] after commit, got %v", arr.Values())
+	}
+
+	// Test that changing the transaction after commit does not change the array
+	tx.Set(0, 5)
+	if v, _ := arr.Get(0); v != 4 {
+		t.Errorf("Expected 4, got %v", v)
+	}
+	if err := tx.Commit(); err == nil {
+		t.Errorf("Expected an error when committing twice")
+	}
+}
+
+func TestTxRollback(t *testing.T) {
+	// Test that a rolled back transaction leaves the array unchanged
+	arr := NewStaticArray(2)
+	tx := arr.Begin()
+	tx.Set(0, 1)
+	if err := tx.Push(2); err == nil {
+		t.Errorf("Expected an error when pushing to a full static array")
+	}
+	tx.Clear()
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if arr.Len() != 2 || arr.Values()[0] != nil {
+		t.Errorf("Expected the array to be unchanged after rollback, got %v", arr.Values())
+	}
+	if err := tx.Rollback(); err == nil {
+		t.Errorf("Expected an error when rolling back twice")
+	}
+}
+
+func TestTxConflict(t *testing.T) {
+	// Test that commit fails if the array was changed outside of the transaction
+	arr := NewDynamicArray()
+	tx := arr.Begin()
+	tx.Push(1)
+	arr.Push(2)
+	if err := tx.Commit(); err == nil {
+		t.Errorf("Expected an error after an outside change")
+	}
+	if !slices.Equal(arr.Values(), []interface{}{2}) {
+		t.Errorf("Expected [2] after the failed commit, got %v", arr.Values())
+	}
+}
+
+func TestTxConflictBetweenTransactions(t *testing.T) {
+	// Test that a commit fails if another transaction committed changes first, and that a no-op change
+	// outside of the transaction is not a conflict
+	arr := NewDynamicArray()
+	tx1, tx2 := arr.Begin(), arr.Begin()
+	arr.Clear()
+	arr.RemoveAllFunc(func(interface{}) bool { return true })
+	tx1.Push(1)
+	tx2.Push(2)
+	if err := tx1.Commit(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := tx2.Commit(); err == nil {
+		t.Errorf("Expected an error after the commit of another transaction")
+	}
+}
+
+func TestTxAbandoned(t *testing.T) {
+	// Test that an abandoned transaction leaves nothing behind on the array
+	arr := NewDynamicArray()
+	for i := 0; i < 10; i++ {
+		arr.Begin().Push(i)
+	}
+	if arr.observers.Active() {
+		t.Errorf("Expected no subscribers after abandoning transactions")
+	}
+	arr.Push(1)
+	tx := arr.Begin()
+	defer tx.Rollback()
+	tx.Push(2)
+	if err := tx.Commit(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestTxEvents(t *testing.T) {
+	// Test that subscribers receive the changes of a commit as a single notification that reproduces the array
+	arr := NewDynamicArray()
+	arr.Push(1)
+	replayed := arr.Values()
+	notifications := 0
+	arr.Subscribe(func(event collections.Event[interface{}]) {
+		replayed = testkit.Replay(replayed, event)
+		notifications++
+	})
+	tx := arr.Begin()
+	tx.Push(2)
+	tx.InsertAt(0, 3)
+	tx.RemoveAllFunc(func(v interface{}) bool { return v == 1 })
+	tx.Commit()
+	if notifications != 1 {
+		t.Errorf("Expected 1 notification, got %d", notifications)
+	}
+	if !slices.Equal(replayed, arr.Values()) {
+		t.Errorf("Expected events to reproduce %v, got %v", arr.Values(), replayed)
+	}
+}
+
+func TestTxConformance(t *testing.T) {
+	// Test that a transaction behaves like every other List
+	testkit.TestList(t, func() collections.List[interface{}] { return NewDynamicArray().Begin() },
+		func(i int) interface{} { return i })
+}
@@ -0,0 +1,132 @@
+// Package cache provides LRU, LFU and TTL-expiring caches built on the project's doubly linked list.
+package cache
+
+import (
+	"time"
+
+	"goCollections/linked_list"
+)
+
+// Cache is the interface implemented by every cache in this package.
+type Cache interface {
+	// Get returns the value stored for key and records the access.
+	Get(key interface{}) (interface{}, bool)
+	// Put stores value for key, evicting other entries if the cache is over capacity.
+	Put(key, value interface{}) bool
+	// Peek returns the value stored for key without recording an access.
+	Peek(key interface{}) (interface{}, bool)
+	// Remove removes the entry for key.
+	Remove(key interface{}) bool
+	// Len returns the number of entries in the cache.
+	Len() int
+	// Stats returns the hit, miss and eviction counts of the cache.
+	Stats() Stats
+}
+
+// EvictionCallback is called with the key and value of every entry evicted from a cache,
+// either to make room for new entries or because the entry expired.
+type EvictionCallback func(key, value interface{})
+
+// CostFunc returns the cost of storing value for key, used to bound a cache by total cost.
+type CostFunc func(key, value interface{}) int
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits        uint64 // Calls to Get that found an entry.
+	Misses      uint64 // Calls to Get that found no entry.
+	Evictions   uint64 // Entries evicted to make room for new entries.
+	Expirations uint64 // Entries removed because they expired.
+}
+
+// HitRatio returns the fraction of calls to Get that found an entry, or 0 if Get was never called.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Option configures a cache.
+type Option func(*config)
+
+// config holds the settings shared by every cache.
+type config struct {
+	capacity int              // The maximum number of entries, or 0 for no limit.
+	maxCost  int              // The maximum total cost of the entries, or 0 for no limit.
+	cost     CostFunc         // The cost of each entry.
+	onEvict  EvictionCallback // Called for every evicted entry, may be nil.
+	now      func() time.Time // The clock used for expiration.
+}
+
+// WithCapacity limits the cache to at most n entries.
+func WithCapacity(n int) Option {
+	return func(c *config) {
+		c.capacity = n
+	}
+}
+
+// WithMaxCost limits the total cost of the entries in the cache to maxCost,
+// where the cost of each entry is computed by cost.
+func WithMaxCost(maxCost int, cost CostFunc) Option {
+	return func(c *config) {
+		c.maxCost = maxCost
+		c.cost = cost
+	}
+}
+
+// WithEvictionCallback sets a function that is called for every entry evicted from the cache.
+func WithEvictionCallback(fn EvictionCallback) Option {
+	return func(c *config) {
+		c.onEvict = fn
+	}
+}
+
+// WithClock sets the function used to read the current time. It is mainly useful for tests.
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		c.now = now
+	}
+}
+
+// newConfig returns the configuration built from opts.
+func newConfig(opts []Option) config {
+	c := config{now: time.Now}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// entryCost returns the cost of storing value for key.
+func (c *config) entryCost(key, value interface{}) int {
+	if c.cost == nil {
+		return 0
+	}
+	return c.cost(key, value)
+}
+
+// fits checks if a single entry of the given cost can be stored at all.
+func (c *config) fits(cost int) bool {
+	return c.maxCost <= 0 || cost <= c.maxCost
+}
+
+// overCapacity checks if a cache holding n entries with the given total cost must evict.
+func (c *config) overCapacity(n, cost int) bool {
+	return (c.capacity > 0 && n > c.capacity) || (c.maxCost > 0 && cost > c.maxCost)
+}
+
+// entry is a key-value pair stored in a cache.
+type entry struct {
+	key     interface{}
+	value   interface{}
+	cost    int
+	expires time.Time                                           // Used by TTL only.
+	node    *linked_list.DoublyLinkedListNode[*entry]           // The node holding the entry.
+	bucket  *linked_list.DoublyLinkedListNode[*frequencyBucket] // Used by LFU only: the node of the entry's frequency bucket.
+}
+
+var (
+	_ Cache = (*LRU)(nil)
+	_ Cache = (*LFU)(nil)
+	_ Cache = (*TTL)(nil)
+)
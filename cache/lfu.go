@@ -0,0 +1,175 @@
+package cache
+
+import "goCollections/linked_list"
+
+// frequencyBucket holds the entries of an LFU cache that have been used the same number of times.
+type frequencyBucket struct {
+	frequency int
+	entries   *linked_list.DoublyLinkedList[*entry] // The most recently used entry at the head.
+}
+
+// LFU is a cache that evicts the least frequently used entry when it is over capacity.
+// Ties are broken by evicting the least recently used of the least frequently used entries.
+// Every operation runs in constant time.
+type LFU struct {
+	config
+	items   map[interface{}]*entry
+	buckets *linked_list.DoublyLinkedList[*frequencyBucket] // In ascending frequency.
+	cost    int
+	stats   Stats
+}
+
+// NewLFU creates and returns a new empty LFU cache configured by opts.
+// Without WithCapacity or WithMaxCost the cache is unbounded.
+func NewLFU(opts ...Option) *LFU {
+	return &LFU{
+		config:  newConfig(opts),
+		items:   make(map[interface{}]*entry),
+		buckets: linked_list.NewDoublyLinkedList[*frequencyBucket](),
+	}
+}
+
+// touch increments the use count of e by moving it to the next frequency bucket.
+func (c *LFU) touch(e *entry) {
+	current := e.bucket
+	bucket := current.Value()
+	next := current.Next()
+	if next == nil || next.Value().frequency != bucket.frequency+1 {
+		next, _ = c.buckets.InsertAfter(current, &frequencyBucket{
+			frequency: bucket.frequency + 1,
+			entries:   linked_list.NewDoublyLinkedList[*entry](),
+		})
+	}
+	c.unlink(e)
+	e.bucket = next
+	e.node = next.Value().entries.PushFront(e)
+}
+
+// unlink removes e from its frequency bucket, dropping the bucket if it becomes empty.
+func (c *LFU) unlink(e *entry) {
+	bucket := e.bucket.Value()
+	bucket.entries.RemoveNode(e.node)
+	if bucket.entries.IsEmpty() {
+		c.buckets.RemoveNode(e.bucket)
+	}
+}
+
+// remove removes e from the cache.
+func (c *LFU) remove(e *entry) {
+	c.unlink(e)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+// evict removes the least frequently used entries until the cache could hold
+// extra more entries with extraCost more cost.
+func (c *LFU) evict(extra, extraCost int) {
+	for len(c.items) > 0 && c.overCapacity(len(c.items)+extra, c.cost+extraCost) {
+		e := c.buckets.Head().Value().entries.Tail().Value()
+		c.remove(e)
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(e.key, e.value)
+		}
+	}
+}
+
+// Get returns the value stored for key and increments the entry's use count.
+// The second result is false if the cache has no entry for key.
+func (c *LFU) Get(key interface{}) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Put stores value for key. Storing a new key first evicts the least frequently used entries
+// until there is room for it, and updating an existing key counts as a use.
+// It returns false if the entry costs more than the maximum cost of the cache and was not stored.
+func (c *LFU) Put(key, value interface{}) bool {
+	cost := c.entryCost(key, value)
+	e, ok := c.items[key]
+	if !c.fits(cost) {
+		if ok {
+			c.remove(e)
+		}
+		return false
+	}
+	if ok {
+		c.cost += cost - e.cost
+		e.value = value
+		e.cost = cost
+		c.touch(e)
+		c.evict(0, 0)
+		return true
+	}
+	c.evict(1, cost)
+	first := c.buckets.Head()
+	if first == nil || first.Value().frequency != 1 {
+		first = c.buckets.PushFront(&frequencyBucket{
+			frequency: 1,
+			entries:   linked_list.NewDoublyLinkedList[*entry](),
+		})
+	}
+	e = &entry{key: key, value: value, cost: cost, bucket: first}
+	e.node = first.Value().entries.PushFront(e)
+	c.items[key] = e
+	c.cost += cost
+	return true
+}
+
+// Peek returns the value stored for key without incrementing the entry's use count.
+// The second result is false if the cache has no entry for key.
+func (c *LFU) Peek(key interface{}) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Frequency returns the number of times the entry for key has been used, or 0 if there is no entry.
+func (c *LFU) Frequency(key interface{}) int {
+	e, ok := c.items[key]
+	if !ok {
+		return 0
+	}
+	return e.bucket.Value().frequency
+}
+
+// Remove removes the entry for key.
+// It returns false if the cache has no entry for key.
+func (c *LFU) Remove(key interface{}) bool {
+	e, ok := c.items[key]
+	if ok {
+		c.remove(e)
+	}
+	return ok
+}
+
+// Len returns the number of entries in the cache.
+func (c *LFU) Len() int {
+	return len(c.items)
+}
+
+// Cost returns the total cost of the entries in the cache.
+func (c *LFU) Cost() int {
+	return c.cost
+}
+
+// Stats returns the hit, miss and eviction counts of the cache.
+func (c *LFU) Stats() Stats {
+	return c.stats
+}
+
+// Clear removes all entries from the cache without calling the eviction callback.
+// The statistics are kept.
+func (c *LFU) Clear() {
+	c.items = make(map[interface{}]*entry)
+	c.buckets.Clear()
+	c.cost = 0
+}
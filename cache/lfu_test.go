@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"testing"
+)
+
+func TestLFUGetPut(t *testing.T) {
+	// Create a new LFU cache with room for two entries
+	evicted := []interface{}{}
+	c := NewLFU(WithCapacity(2), WithEvictionCallback(func(key, value interface{}) {
+		evicted = append(evicted, key)
+	}))
+
+	// Use a more often than b
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	if c.Frequency("a") != 3 || c.Frequency("b") != 2 {
+		t.Errorf("Expected frequencies 3 and 2, got %d and %d", c.Frequency("a"), c.Frequency("b"))
+	}
+
+	// Test that the least frequently used entry is evicted
+	c.Put("c", 3)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("Expected eviction callback for b, got %v", evicted)
+	}
+
+	// Test that the new entry is not evicted immediately and ties go to the least recent
+	c.Put("d", 4)
+	if _, ok := c.Peek("c"); ok {
+		t.Errorf("Expected c to be evicted")
+	}
+	if v, ok := c.Get("d"); !ok || v != 4 {
+		t.Errorf("Expected d to be 4, got %v", v)
+	}
+
+	// Test that Peek does not change frequency
+	c.Peek("d")
+	if c.Frequency("d") != 2 {
+		t.Errorf("Expected frequency of d to be 2, got %d", c.Frequency("d"))
+	}
+
+	// Test Remove
+	if !c.Remove("a") || c.Len() != 1 {
+		t.Errorf("Expected a to be removed")
+	}
+	if c.Frequency("a") != 0 {
+		t.Errorf("Expected frequency of a to be 0, got %d", c.Frequency("a"))
+	}
+}
+
+func TestLFUMaxCostAndStats(t *testing.T) {
+	// Create a new LFU cache where every entry costs its value
+	c := NewLFU(WithMaxCost(10, func(key, value interface{}) int {
+		return value.(int)
+	}))
+
+	// Test eviction by cost
+	c.Put("a", 6)
+	c.Get("a")
+	c.Put("b", 3)
+	c.Put("c", 4)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if c.Cost() != 10 {
+		t.Errorf("Expected cost to be 10, got %d", c.Cost())
+	}
+	if c.Put("d", 11) {
+		t.Errorf("Expected Put to return false")
+	}
+
+	// Test the counters
+	c.Get("missing")
+	stats := c.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	// Test Clear
+	c.Clear()
+	if c.Len() != 0 || c.Cost() != 0 {
+		t.Errorf("Expected cache to be empty")
+	}
+	c.Put("e", 1)
+	if c.Frequency("e") != 1 {
+		t.Errorf("Expected frequency of e to be 1, got %d", c.Frequency("e"))
+	}
+}
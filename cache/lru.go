@@ -0,0 +1,152 @@
+package cache
+
+import "goCollections/linked_list"
+
+// listCache holds the entries of a cache in a doubly linked list, with the entry to evict next at the tail.
+type listCache struct {
+	config
+	items map[interface{}]*entry
+	order *linked_list.DoublyLinkedList[*entry]
+	cost  int
+	stats Stats
+}
+
+// newListCache creates an empty listCache configured by opts.
+func newListCache(opts []Option) listCache {
+	return listCache{
+		config: newConfig(opts),
+		items:  make(map[interface{}]*entry),
+		order:  linked_list.NewDoublyLinkedList[*entry](),
+	}
+}
+
+// store adds or updates the entry for key at the head of the list and returns it.
+// It returns nil if the entry is too costly to be stored, removing any existing entry for key.
+func (c *listCache) store(key, value interface{}) *entry {
+	cost := c.entryCost(key, value)
+	e, ok := c.items[key]
+	if !c.fits(cost) {
+		if ok {
+			c.remove(e)
+		}
+		return nil
+	}
+	if ok {
+		c.cost += cost - e.cost
+		e.value = value
+		e.cost = cost
+		c.order.MoveToFront(e.node)
+	} else {
+		e = &entry{key: key, value: value, cost: cost}
+		e.node = c.order.PushFront(e)
+		c.items[key] = e
+		c.cost += cost
+	}
+	return e
+}
+
+// remove removes e from the cache.
+func (c *listCache) remove(e *entry) {
+	c.order.RemoveNode(e.node)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+// evict removes entries from the tail of the list until the cache is within capacity.
+func (c *listCache) evict() {
+	for c.overCapacity(len(c.items), c.cost) {
+		e := c.order.Tail().Value()
+		c.remove(e)
+		c.stats.Evictions++
+		if c.onEvict != nil {
+			c.onEvict(e.key, e.value)
+		}
+	}
+}
+
+// Remove removes the entry for key.
+// It returns false if the cache has no entry for key.
+func (c *listCache) Remove(key interface{}) bool {
+	e, ok := c.items[key]
+	if ok {
+		c.remove(e)
+	}
+	return ok
+}
+
+// Stats returns the hit, miss and eviction counts of the cache.
+func (c *listCache) Stats() Stats {
+	return c.stats
+}
+
+// Cost returns the total cost of the entries in the cache.
+func (c *listCache) Cost() int {
+	return c.cost
+}
+
+// Clear removes all entries from the cache without calling the eviction callback.
+// The statistics are kept.
+func (c *listCache) Clear() {
+	c.items = make(map[interface{}]*entry)
+	c.order.Clear()
+	c.cost = 0
+}
+
+// LRU is a cache that evicts the least recently used entry when it is over capacity.
+type LRU struct {
+	listCache
+}
+
+// NewLRU creates and returns a new empty LRU cache configured by opts.
+// Without WithCapacity or WithMaxCost the cache is unbounded.
+func NewLRU(opts ...Option) *LRU {
+	return &LRU{newListCache(opts)}
+}
+
+// Get returns the value stored for key and marks the entry as the most recently used.
+// The second result is false if the cache has no entry for key.
+func (c *LRU) Get(key interface{}) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e.node)
+	return e.value, true
+}
+
+// Put stores value for key as the most recently used entry,
+// evicting the least recently used entries if the cache is over capacity.
+// It returns false if the entry costs more than the maximum cost of the cache and was not stored.
+func (c *LRU) Put(key, value interface{}) bool {
+	if c.store(key, value) == nil {
+		return false
+	}
+	c.evict()
+	return true
+}
+
+// Peek returns the value stored for key without marking the entry as used.
+// The second result is false if the cache has no entry for key.
+func (c *LRU) Peek(key interface{}) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRU) Len() int {
+	return len(c.items)
+}
+
+// Keys returns the keys in the cache from the most to the least recently used.
+func (c *LRU) Keys() []interface{} {
+	keys := make([]interface{}, 0, len(c.items))
+	for n := c.order.Head(); n != nil; n = n.Next() {
+		keys = append(keys, n.Value().key)
+	}
+	return keys
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"testing"
+)
+
+func TestLRUGetPut(t *testing.T) {
+	// Create a new LRU cache with room for two entries
+	evicted := []interface{}{}
+	c := NewLRU(WithCapacity(2), WithEvictionCallback(func(key, value interface{}) {
+		evicted = append(evicted, key)
+	}))
+
+	// Test Put and Get
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected a to be 1, got %v", v)
+	}
+
+	// Test that the least recently used entry is evicted
+	c.Put("c", 3)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("Expected eviction callback for b, got %v", evicted)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected cache length to be 2, got %d", c.Len())
+	}
+
+	// Test Keys order
+	keys := c.Keys()
+	if keys[0] != "c" || keys[1] != "a" {
+		t.Errorf("Expected keys to be [c a], got %v", keys)
+	}
+
+	// Test that Peek does not update recency
+	c.Peek("a")
+	c.Put("d", 4)
+	if _, ok := c.Peek("a"); ok {
+		t.Errorf("Expected a to be evicted")
+	}
+
+	// Test updating an existing key
+	c.Put("c", 30)
+	if v, _ := c.Get("c"); v != 30 {
+		t.Errorf("Expected c to be 30, got %v", v)
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected cache length to be 2, got %d", c.Len())
+	}
+
+	// Test Remove
+	if !c.Remove("c") || c.Remove("c") {
+		t.Errorf("Expected c to be removed once")
+	}
+}
+
+func TestLRUStats(t *testing.T) {
+	// Create a new LRU cache
+	c := NewLRU(WithCapacity(1))
+	c.Put("a", 1)
+	c.Get("a")
+	c.Get("b")
+	c.Put("b", 2)
+
+	// Test the counters
+	stats := c.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	if stats.HitRatio() != 0.5 {
+		t.Errorf("Expected hit ratio to be 0.5, got %v", stats.HitRatio())
+	}
+}
+
+func TestLRUMaxCost(t *testing.T) {
+	// Create a new LRU cache bounded by the length of the values
+	c := NewLRU(WithMaxCost(10, func(key, value interface{}) int {
+		return len(value.(string))
+	}))
+
+	// Test eviction by cost
+	c.Put("a", "12345")
+	c.Put("b", "1234")
+	c.Put("c", "123")
+	if c.Len() != 2 || c.Cost() != 7 {
+		t.Errorf("Expected 2 entries costing 7, got %d costing %d", c.Len(), c.Cost())
+	}
+	if _, ok := c.Peek("a"); ok {
+		t.Errorf("Expected a to be evicted")
+	}
+
+	// Test an entry that can never fit
+	if c.Put("d", "12345678901") {
+		t.Errorf("Expected Put to return false")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected cache length to be 2, got %d", c.Len())
+	}
+
+	// Test Clear
+	c.Clear()
+	if c.Len() != 0 || c.Cost() != 0 {
+		t.Errorf("Expected cache to be empty")
+	}
+}
@@ -0,0 +1,95 @@
+package cache
+
+import "time"
+
+// TTL is a cache whose entries expire a fixed duration after they were last stored.
+// When it is over capacity, it evicts the entries that will expire soonest.
+type TTL struct {
+	listCache
+	ttl time.Duration
+}
+
+// NewTTL creates and returns a new empty TTL cache whose entries expire after ttl.
+// Without WithCapacity or WithMaxCost the number of unexpired entries is unbounded.
+func NewTTL(ttl time.Duration, opts ...Option) *TTL {
+	return &TTL{newListCache(opts), ttl}
+}
+
+// lookup returns the unexpired entry for key, removing it if it has expired.
+func (c *TTL) lookup(key interface{}) (*entry, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		c.expire(e)
+		return nil, false
+	}
+	return e, true
+}
+
+// expire removes the expired entry e and reports it to the eviction callback.
+func (c *TTL) expire(e *entry) {
+	c.remove(e)
+	c.stats.Expirations++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// Get returns the value stored for key if it has not expired.
+// The second result is false if the cache has no unexpired entry for key.
+func (c *TTL) Get(key interface{}) (interface{}, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	return e.value, true
+}
+
+// Put stores value for key and resets its expiration time,
+// evicting the entries closest to expiring if the cache is over capacity.
+// It returns false if the entry costs more than the maximum cost of the cache and was not stored.
+func (c *TTL) Put(key, value interface{}) bool {
+	e := c.store(key, value)
+	if e == nil {
+		return false
+	}
+	e.expires = c.now().Add(c.ttl)
+	c.RemoveExpired()
+	c.evict()
+	return true
+}
+
+// Peek returns the value stored for key if it has not expired, without recording a hit or miss.
+// The second result is false if the cache has no unexpired entry for key.
+func (c *TTL) Peek(key interface{}) (interface{}, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		return nil, false
+	}
+	return e.value, true
+}
+
+// RemoveExpired removes every expired entry from the cache and returns how many were removed.
+func (c *TTL) RemoveExpired() int {
+	now := c.now()
+	removed := 0
+	for tail := c.order.Tail(); tail != nil; tail = c.order.Tail() {
+		e := tail.Value()
+		if now.Before(e.expires) {
+			break
+		}
+		c.expire(e)
+		removed++
+	}
+	return removed
+}
+
+// Len returns the number of unexpired entries in the cache.
+func (c *TTL) Len() int {
+	c.RemoveExpired()
+	return len(c.items)
+}
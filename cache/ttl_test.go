@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTTLExpiration(t *testing.T) {
+	// Create a new TTL cache with a fake clock
+	now := time.Unix(0, 0)
+	expired := []interface{}{}
+	c := NewTTL(time.Minute, WithClock(func() time.Time { return now }), WithEvictionCallback(func(key, value interface{}) {
+		expired = append(expired, key)
+	}))
+
+	// Test entries before they expire
+	c.Put("a", 1)
+	now = now.Add(30 * time.Second)
+	c.Put("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Expected a to be 1, got %v", v)
+	}
+
+	// Test that a expires first
+	now = now.Add(30 * time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Expected a to be expired")
+	}
+	if v, ok := c.Peek("b"); !ok || v != 2 {
+		t.Errorf("Expected b to be 2, got %v", v)
+	}
+	if len(expired) != 1 || expired[0] != "a" {
+		t.Errorf("Expected eviction callback for a, got %v", expired)
+	}
+
+	// Test that Put resets the expiration time
+	c.Put("b", 20)
+	now = now.Add(45 * time.Second)
+	if v, ok := c.Get("b"); !ok || v != 20 {
+		t.Errorf("Expected b to be 20, got %v", v)
+	}
+
+	// Test Len and RemoveExpired
+	c.Put("c", 3)
+	now = now.Add(30 * time.Second)
+	if c.Len() != 1 {
+		t.Errorf("Expected cache length to be 1, got %d", c.Len())
+	}
+	now = now.Add(time.Minute)
+	if c.RemoveExpired() != 1 {
+		t.Errorf("Expected one entry to be removed")
+	}
+
+	// Test the counters
+	stats := c.Stats()
+	if stats.Hits != 2 || stats.Misses != 1 || stats.Expirations != 3 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestTTLCapacity(t *testing.T) {
+	// Create a new TTL cache with room for two entries
+	now := time.Unix(0, 0)
+	c := NewTTL(time.Minute, WithCapacity(2), WithClock(func() time.Time { return now }))
+
+	// Test that the entry closest to expiring is evicted
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 10)
+	c.Put("c", 3)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if c.Stats().Evictions != 1 {
+		t.Errorf("Expected one eviction, got %d", c.Stats().Evictions)
+	}
+	if !c.Remove("a") || c.Len() != 1 {
+		t.Errorf("Expected a to be removed")
+	}
+}
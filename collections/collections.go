@@ -0,0 +1,54 @@
+// Package collections defines the interfaces shared by the data structures in this module,
+// so that code can accept any collection instead of a specific type.
+package collections
+
+// Collection is implemented by every data structure that holds a finite number of elements of type T.
+type Collection[T any] interface {
+	// Len returns the number of elements in the collection.
+	Len() int
+	// IsEmpty returns true if the collection has no elements, false otherwise.
+	IsEmpty() bool
+	// Clear removes all elements from the collection.
+	Clear()
+	// Values returns a new slice containing the elements of the collection.
+	Values() []T
+}
+
+// List is a Collection whose elements can be accessed and modified by index.
+// Methods that take an index return an error if the index is out of range.
+type List[T any] interface {
+	Collection[T]
+	// Get returns the element at the specified index.
+	Get(index int) (T, error)
+	// Set replaces the element at the specified index.
+	Set(index int, value T) error
+	// InsertAt inserts an element at the specified index, shifting the following elements.
+	InsertAt(index int, value T) error
+	// RemoveAt removes the element at the specified index, shifting the following elements.
+	RemoveAt(index int) error
+}
+
+// SetLike is a Collection that holds each element at most once.
+type SetLike[T any] interface {
+	Collection[T]
+	// Add adds an element, if it is not already present.
+	Add(value T)
+	// Remove removes an element, if it is present.
+	Remove(value T)
+	// Contains checks if an element is present.
+	Contains(value T) bool
+}
+
+// Queue is a Collection whose elements are removed in the order they were added.
+// Methods that read an element return an error if the queue is empty.
+type Queue[T any] interface {
+	Collection[T]
+	// Enqueue adds an element to the end of the queue.
+	Enqueue(value T)
+	// Dequeue removes and returns the element at the front of the queue.
+	Dequeue() (T, error)
+	// Head returns the element at the front of the queue without removing it.
+	Head() (T, error)
+	// Tail returns the element at the end of the queue without removing it.
+	Tail() (T, error)
+}
@@ -0,0 +1,120 @@
+package collections
+
+// EventKind identifies the kind of change an Event describes.
+type EventKind int
+
+const (
+	// Added means that Value was added at Index.
+	Added EventKind = iota
+	// Removed means that Value was removed from Index.
+	Removed
+	// Updated means that the element at Index was replaced: Old is the previous value and Value the new one.
+	Updated
+	// Cleared means that every element was removed at once.
+	Cleared
+	// Batch means that a bulk operation made several changes, listed in order in Events.
+	Batch
+)
+
+// String returns the name of the event kind.
+func (k EventKind) String() string {
+	switch k {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Updated:
+		return "Updated"
+	case Cleared:
+		return "Cleared"
+	case Batch:
+		return "Batch"
+	}
+	return "Unknown"
+}
+
+// Event describes a change to an observable collection.
+// Index is the position of the element when the change was made, or -1 if the collection is unordered
+// or the event is Cleared or Batch. Applying the events in order to a copy of the collection,
+// including the ones in a Batch, reproduces the collection.
+type Event[T any] struct {
+	Kind   EventKind
+	Index  int
+	Value  T          // The added, removed or new value.
+	Old    T          // The previous value, for Updated events.
+	Events []Event[T] // The changes of a Batch event.
+}
+
+// subscriber is a function registered with Observers.Subscribe.
+type subscriber[T any] struct {
+	id int
+	fn func(Event[T])
+}
+
+// Observers keeps the subscribers of an observable collection and delivers events to them.
+// A collection holds one and calls Emit whenever it changes; the zero value has no subscribers.
+type Observers[T any] struct {
+	subscribers []subscriber[T]
+	nextID      int
+	batching    int        // The nesting depth of Batch calls.
+	pending     []Event[T] // The events emitted during the outermost Batch call.
+}
+
+// Subscribe registers fn to be called with every event, and returns a function that unregisters it.
+// Calling the returned function more than once has no further effect.
+func (o *Observers[T]) Subscribe(fn func(Event[T])) (unsubscribe func()) {
+	id := o.nextID
+	o.nextID++
+	o.subscribers = append(o.subscribers, subscriber[T]{id, fn})
+	return func() {
+		for i, s := range o.subscribers {
+			if s.id == id {
+				o.subscribers = append(o.subscribers[:i:i], o.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Active returns true if there are subscribers, so that collections can skip building events nobody receives.
+func (o *Observers[T]) Active() bool {
+	return len(o.subscribers) > 0
+}
+
+// Emit delivers the event to every subscriber, in the order they subscribed.
+// During a Batch call, the event is held back and delivered with the others when the batch ends.
+func (o *Observers[T]) Emit(event Event[T]) {
+	if !o.Active() {
+		return
+	}
+	if o.batching > 0 {
+		o.pending = append(o.pending, event)
+		return
+	}
+	for _, s := range o.subscribers {
+		s.fn(event)
+	}
+}
+
+// Batch calls fn and delivers the events emitted while it runs as a single notification:
+// nothing if there were none, the event itself if there was one, or a Batch event holding all of them.
+// Nested calls are delivered with the outermost one.
+func (o *Observers[T]) Batch(fn func()) {
+	o.batching++
+	defer func() {
+		o.batching--
+		if o.batching > 0 {
+			return
+		}
+		pending := o.pending
+		o.pending = nil
+		switch len(pending) {
+		case 0:
+		case 1:
+			o.Emit(pending[0])
+		default:
+			o.Emit(Event[T]{Kind: Batch, Index: -1, Events: pending})
+		}
+	}()
+	fn()
+}
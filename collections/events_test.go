@@ -0,0 +1,73 @@
+package collections
+
+import "testing"
+
+func TestObserversSubscribe(t *testing.T) {
+	// Test that every subscriber receives events in order until it unsubscribes
+	var o Observers[int]
+	if o.Active() {
+		t.Errorf("Expected no subscribers")
+	}
+	var first, second []int
+	unsubscribe := o.Subscribe(func(e Event[int]) { first = append(first, e.Value) })
+	o.Subscribe(func(e Event[int]) { second = append(second, e.Value) })
+	if !o.Active() {
+		t.Errorf("Expected subscribers")
+	}
+
+	o.Emit(Event[int]{Kind: Added, Value: 1})
+	unsubscribe()
+	unsubscribe()
+	o.Emit(Event[int]{Kind: Added, Value: 2})
+
+	if len(first) != 1 || first[0] != 1 {
+		t.Errorf("Expected the first subscriber to receive [1], got %v", first)
+	}
+	if len(second) != 2 || second[0] != 1 || second[1] != 2 {
+		t.Errorf("Expected the second subscriber to receive [1 2], got %v", second)
+	}
+}
+
+func TestObserversBatch(t *testing.T) {
+	// Test that a batch delivers nothing, a single event, or one Batch event, and that nested batches are merged
+	var o Observers[int]
+	var received []Event[int]
+	o.Subscribe(func(e Event[int]) { received = append(received, e) })
+
+	o.Batch(func() {})
+	if len(received) != 0 {
+		t.Errorf("Expected no events from an empty batch, got %v", received)
+	}
+
+	o.Batch(func() { o.Emit(Event[int]{Kind: Removed, Value: 1}) })
+	if len(received) != 1 || received[0].Kind != Removed {
+		t.Errorf("Expected a single Removed event, got %v", received)
+	}
+
+	received = nil
+	o.Batch(func() {
+		o.Emit(Event[int]{Kind: Added, Value: 1})
+		o.Batch(func() {
+			o.Emit(Event[int]{Kind: Added, Value: 2})
+		})
+		if len(received) != 0 {
+			t.Errorf("Expected no events before the outermost batch ends, got %v", received)
+		}
+		o.Emit(Event[int]{Kind: Added, Value: 3})
+	})
+	if len(received) != 1 || received[0].Kind != Batch || len(received[0].Events) != 3 {
+		t.Fatalf("Expected one Batch event of 3 events, got %v", received)
+	}
+	for i, e := range received[0].Events {
+		if e.Value != i+1 {
+			t.Errorf("Expected event %d to have value %d, got %d", i, i+1, e.Value)
+		}
+	}
+}
+
+func TestEventKindString(t *testing.T) {
+	// Test the names of the event kinds
+	if Added.String() != "Added" || Batch.String() != "Batch" || EventKind(-1).String() != "Unknown" {
+		t.Errorf("Expected Added, Batch and Unknown, got %v, %v and %v", Added, Batch, EventKind(-1))
+	}
+}
@@ -0,0 +1,309 @@
+package history
+
+import (
+	"errors"
+
+	"goCollections/collections"
+)
+
+// opKind identifies the change a command makes to a list.
+type opKind int
+
+const (
+	opInsert  opKind = iota // Inserts value at index.
+	opRemove                // Removes value from index.
+	opSet                   // Replaces old with value at index.
+	opClear                 // Removes values, which are every element of the list.
+	opRestore               // Inserts values into an empty list.
+	opReverse               // Reverses the list.
+)
+
+// command is an invertible change to a list.
+type command[T any] struct {
+	kind   opKind
+	index  int
+	value  T
+	old    T
+	values []T
+}
+
+// inverse returns the command that undoes c.
+func (c command[T]) inverse() command[T] {
+	switch c.kind {
+	case opInsert:
+		c.kind = opRemove
+	case opRemove:
+		c.kind = opInsert
+	case opSet:
+		c.value, c.old = c.old, c.value
+	case opClear:
+		c.kind = opRestore
+	case opRestore:
+		c.kind = opClear
+	}
+	return c
+}
+
+// Option configures a History.
+type Option func(*config)
+
+// config holds the settings of a history.
+type config struct {
+	depth int
+}
+
+// WithDepth limits the history to the last depth changes; older changes can no longer be undone.
+// A depth of 0, the default, keeps every change.
+func WithDepth(depth int) Option {
+	return func(c *config) {
+		c.depth = depth
+	}
+}
+
+// History wraps a list and records every change made through it, so that changes can be undone and redone.
+// Changes made to the list directly, rather than through the history, are not recorded, and undoing
+// changes recorded before them may fail.
+type History[T any] struct {
+	list        collections.List[T]
+	undo        []command[T]   // The changes that can be undone, the most recent last.
+	redo        []command[T]   // The undone changes that can be redone, the most recently undone last.
+	dropped     int            // The number of changes dropped from the start of the history because of the depth.
+	depth       int            // The maximum number of changes to keep, or 0 for no limit.
+	checkpoints map[string]int // The positions of the named checkpoints.
+}
+
+var _ collections.List[int] = (*History[int])(nil)
+
+// NewHistory creates and returns a new History that records the changes made through it to list.
+func NewHistory[T any](list collections.List[T], opts ...Option) *History[T] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.depth < 0 {
+		c.depth = 0
+	}
+	return &History[T]{list: list, depth: c.depth, checkpoints: make(map[string]int)}
+}
+
+// List returns the list the history wraps.
+func (h *History[T]) List() collections.List[T] {
+	return h.list
+}
+
+// position returns the number of changes applied to the list since the history was created.
+func (h *History[T]) position() int {
+	return h.dropped + len(h.undo)
+}
+
+// apply makes the change c to the list.
+func (h *History[T]) apply(c command[T]) error {
+	switch c.kind {
+	case opInsert:
+		return h.list.InsertAt(c.index, c.value)
+	case opRemove:
+		return h.list.RemoveAt(c.index)
+	case opSet:
+		return h.list.Set(c.index, c.value)
+	case opClear:
+		h.list.Clear()
+	case opRestore:
+		if !h.list.IsEmpty() {
+			return errors.New("list was modified outside of the history")
+		}
+		for i, value := range c.values {
+			if err := h.list.InsertAt(i, value); err != nil {
+				return err
+			}
+		}
+	case opReverse:
+		return reverse(h.list)
+	}
+	return nil
+}
+
+// reverse reverses list with its own Reverse method if it has one, or by swapping values otherwise.
+func reverse[T any](list collections.List[T]) error {
+	if r, ok := list.(interface{ Reverse() }); ok {
+		r.Reverse()
+		return nil
+	}
+	for i, j := 0, list.Len()-1; i < j; i, j = i+1, j-1 {
+		a, err := list.Get(i)
+		if err != nil {
+			return err
+		}
+		b, err := list.Get(j)
+		if err != nil {
+			return err
+		}
+		if err := list.Set(i, b); err != nil {
+			return err
+		}
+		if err := list.Set(j, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// record applies the change c and adds it to the history. It discards the changes that could be redone,
+// and the checkpoints that pointed at them, and drops the oldest change if the history is full.
+func (h *History[T]) record(c command[T]) error {
+	if err := h.apply(c); err != nil {
+		return err
+	}
+	h.undo = append(h.undo, c)
+	h.redo = nil
+	position := h.position()
+	for name, p := range h.checkpoints {
+		if p >= position {
+			delete(h.checkpoints, name)
+		}
+	}
+	if h.depth > 0 && len(h.undo) > h.depth {
+		h.undo[0] = command[T]{}
+		h.undo = h.undo[1:]
+		h.dropped++
+		for name, p := range h.checkpoints {
+			if p < h.dropped {
+				delete(h.checkpoints, name)
+			}
+		}
+	}
+	return nil
+}
+
+// Add adds value to the end of the list.
+func (h *History[T]) Add(value T) error {
+	return h.InsertAt(h.list.Len(), value)
+}
+
+// InsertAt inserts value at the specified index in the list.
+// If the index is out of range, it returns an error and records nothing.
+func (h *History[T]) InsertAt(index int, value T) error {
+	return h.record(command[T]{kind: opInsert, index: index, value: value})
+}
+
+// RemoveAt removes the element at the specified index from the list.
+// If the index is out of range, it returns an error and records nothing.
+func (h *History[T]) RemoveAt(index int) error {
+	value, err := h.list.Get(index)
+	if err != nil {
+		return err
+	}
+	return h.record(command[T]{kind: opRemove, index: index, value: value})
+}
+
+// Set sets the value at the specified index in the list.
+// If the index is out of range, it returns an error and records nothing.
+func (h *History[T]) Set(index int, value T) error {
+	old, err := h.list.Get(index)
+	if err != nil {
+		return err
+	}
+	return h.record(command[T]{kind: opSet, index: index, value: value, old: old})
+}
+
+// Clear removes all elements from the list. Clearing an empty list records nothing.
+func (h *History[T]) Clear() {
+	if h.list.IsEmpty() {
+		return
+	}
+	h.record(command[T]{kind: opClear, values: h.list.Values()})
+}
+
+// Reverse reverses the list. It uses the Reverse method of the list if it has one,
+// and swaps values with Get and Set otherwise.
+func (h *History[T]) Reverse() error {
+	return h.record(command[T]{kind: opReverse})
+}
+
+// Get returns the value at the specified index in the list.
+func (h *History[T]) Get(index int) (T, error) {
+	return h.list.Get(index)
+}
+
+// Len returns the number of elements in the list.
+func (h *History[T]) Len() int {
+	return h.list.Len()
+}
+
+// IsEmpty returns true if the list is empty, otherwise returns false.
+func (h *History[T]) IsEmpty() bool {
+	return h.list.IsEmpty()
+}
+
+// Values returns the values of the list.
+func (h *History[T]) Values() []T {
+	return h.list.Values()
+}
+
+// CanUndo checks if there is a change to undo.
+func (h *History[T]) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+// CanRedo checks if there is an undone change to redo.
+func (h *History[T]) CanRedo() bool {
+	return len(h.redo) > 0
+}
+
+// Undo undoes the most recent change that has not been undone.
+// If there is none, or the list was modified outside of the history so that the change
+// cannot be undone, it returns an error.
+func (h *History[T]) Undo() error {
+	if len(h.undo) == 0 {
+		return errors.New("nothing to undo")
+	}
+	c := h.undo[len(h.undo)-1]
+	if err := h.apply(c.inverse()); err != nil {
+		return err
+	}
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, c)
+	return nil
+}
+
+// Redo makes the most recently undone change again.
+// If there is none, or the list was modified outside of the history so that the change
+// cannot be made, it returns an error.
+func (h *History[T]) Redo() error {
+	if len(h.redo) == 0 {
+		return errors.New("nothing to redo")
+	}
+	c := h.redo[len(h.redo)-1]
+	if err := h.apply(c); err != nil {
+		return err
+	}
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, c)
+	return nil
+}
+
+// Checkpoint names the current state of the list, so that RestoreTo can return to it.
+// Reusing a name moves the checkpoint.
+func (h *History[T]) Checkpoint(name string) {
+	h.checkpoints[name] = h.position()
+}
+
+// RestoreTo undoes or redoes changes until the list is in the state named by the checkpoint.
+// A checkpoint is forgotten when the changes after it are discarded by a new change, or the changes
+// before it are dropped because of the depth. If there is no such checkpoint, it returns an error.
+func (h *History[T]) RestoreTo(name string) error {
+	target, ok := h.checkpoints[name]
+	if !ok {
+		return errors.New("checkpoint not found")
+	}
+	for h.position() > target {
+		if err := h.Undo(); err != nil {
+			return err
+		}
+	}
+	for h.position() < target {
+		if err := h.Redo(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
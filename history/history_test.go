@@ -0,0 +1,272 @@
+package history
+
+import (
+	"slices"
+	"testing"
+
+	"goCollections/array"
+	"goCollections/collections"
+	"goCollections/linked_list"
+	"goCollections/testkit"
+)
+
+// expectValues checks that the history holds the expected values.
+func expectValues[T comparable](t *testing.T, h *History[T], expected ...T) {
+	t.Helper()
+	if values := h.Values(); !slices.Equal(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+}
+
+func TestHistoryUndoRedo(t *testing.T) {
+	// Test undoing and redoing every kind of change to a doubly linked list
+	h := NewHistory[int](linked_list.NewDoublyLinkedList[int]())
+	h.Add(1)
+	h.Add(2)
+	h.InsertAt(0, 3)
+	h.Set(1, 4)
+	h.RemoveAt(2)
+	h.Reverse()
+	h.Clear()
+	expectValues(t, h)
+
+	states := [][]int{{4, 3}, {3, 4}, {3, 4, 2}, {3, 1, 2}, {1, 2}, {1}, {}}
+	for _, state := range states {
+		if err := h.Undo(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		expectValues(t, h, state...)
+	}
+	if err := h.Undo(); err == nil {
+		t.Errorf("Expected an error when there is nothing to undo")
+	}
+
+	for i := len(states) - 2; i >= 0; i-- {
+		if err := h.Redo(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		expectValues(t, h, states[i]...)
+	}
+	h.Redo()
+	expectValues(t, h)
+	if err := h.Redo(); err == nil {
+		t.Errorf("Expected an error when there is nothing to redo")
+	}
+}
+
+func TestHistoryNewChangeDiscardsRedo(t *testing.T) {
+	// Test that a new change after an undo cannot be followed by a redo of the undone change
+	h := NewHistory[int](linked_list.NewDoublyLinkedList[int]())
+	h.Add(1)
+	h.Add(2)
+	h.Undo()
+	h.Add(3)
+	if h.CanRedo() {
+		t.Errorf("Expected no change to redo")
+	}
+	expectValues(t, h, 1, 3)
+}
+
+func TestHistoryFailedChange(t *testing.T) {
+	// Test that a change that fails is not recorded
+	h := NewHistory[int](linked_list.NewDoublyLinkedList[int]())
+	if err := h.InsertAt(1, 1); err == nil {
+		t.Errorf("Expected an error for an out-of-range index")
+	}
+	if err := h.RemoveAt(0); err == nil {
+		t.Errorf("Expected an error for an out-of-range index")
+	}
+	if err := h.Set(0, 1); err == nil {
+		t.Errorf("Expected an error for an out-of-range index")
+	}
+	h.Clear()
+	if h.CanUndo() {
+		t.Errorf("Expected no change to undo")
+	}
+}
+
+func TestHistoryArray(t *testing.T) {
+	// Test a history of an array, which is reversed by swapping values
+	h := NewHistory[interface{}](array.NewDynamicArray())
+	h.Add(1)
+	h.Add("two")
+	h.Add(3.0)
+	h.Reverse()
+	expectValues[interface{}](t, h, 3.0, "two", 1)
+	h.Undo()
+	expectValues[interface{}](t, h, 1, "two", 3.0)
+}
+
+func TestHistoryLinkedList(t *testing.T) {
+	// Test a history of a singly linked list, through the view that implements List
+	list := linked_list.NewLinkedList[int]()
+	h := NewHistory[int](list.AsList())
+	h.Add(1)
+	h.Add(2)
+	h.InsertAt(0, 3)
+	h.RemoveAt(1)
+	h.Reverse()
+	expectValues(t, h, 2, 3)
+	for h.CanUndo() {
+		if err := h.Undo(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if list.Size() != 0 {
+		t.Errorf("Expected the list to be empty after undoing every change, got %v", list.Values())
+	}
+}
+
+func TestHistoryDepth(t *testing.T) {
+	// Test that only the last depth changes can be undone
+	h := NewHistory[int](linked_list.NewDoublyLinkedList[int](), WithDepth(2))
+	h.Checkpoint("empty")
+	h.Add(1)
+	h.Checkpoint("one")
+	h.Add(2)
+	h.Add(3)
+
+	h.Undo()
+	h.Undo()
+	if err := h.Undo(); err == nil {
+		t.Errorf("Expected an error beyond the history depth")
+	}
+	expectValues(t, h, 1)
+
+	if err := h.RestoreTo("empty"); err == nil {
+		t.Errorf("Expected an error for a checkpoint beyond the history depth")
+	}
+	if err := h.RestoreTo("one"); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestHistoryCheckpoints(t *testing.T) {
+	// Test restoring checkpoints backward and forward
+	h := NewHistory[int](linked_list.NewDoublyLinkedList[int]())
+	h.Checkpoint("empty")
+	h.Add(1)
+	h.Add(2)
+	h.Checkpoint("two")
+	h.Set(0, 5)
+	h.Clear()
+	h.Checkpoint("cleared")
+
+	if err := h.RestoreTo("two"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expectValues(t, h, 1, 2)
+	h.RestoreTo("empty")
+	expectValues(t, h)
+	h.RestoreTo("cleared")
+	expectValues(t, h)
+	h.RestoreTo("two")
+	expectValues(t, h, 1, 2)
+
+	// Test that checkpoints after a discarded change are forgotten
+	h.Add(3)
+	if err := h.RestoreTo("cleared"); err == nil {
+		t.Errorf("Expected an error for a discarded checkpoint")
+	}
+	if err := h.RestoreTo("missing"); err == nil {
+		t.Errorf("Expected an error for a missing checkpoint")
+	}
+	h.RestoreTo("two")
+	expectValues(t, h, 1, 2)
+}
+
+func TestHistoryOutsideModification(t *testing.T) {
+	// Test that an undo that no longer fits the list returns an error and can be retried
+	list := linked_list.NewDoublyLinkedList[int]()
+	h := NewHistory[int](list)
+	h.Add(1)
+	list.Clear()
+	if err := h.Undo(); err == nil {
+		t.Errorf("Expected an error after an outside modification")
+	}
+	if !h.CanUndo() {
+		t.Errorf("Expected the change to remain undoable")
+	}
+}
+
+func TestHistoryConformance(t *testing.T) {
+	// Test that a History behaves like every other List
+	testkit.TestList(t, func() collections.List[int] {
+		return NewHistory[int](linked_list.NewDoublyLinkedList[int]())
+	}, func(i int) int { return i })
+}
+
+func FuzzHistory(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 5, 0, 5, 0, 6, 0, 3, 1, 4, 0, 5, 0})
+	f.Add([]byte{0, 1, 1, 0, 2, 7, 5, 0, 0, 3, 6, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a History and to a stack of snapshots, and compare them after every operation
+		h := NewHistory[int](linked_list.NewDoublyLinkedList[int](), WithDepth(4))
+		var model []int
+		var undo, redo [][]int
+		// change records a successful change that turned the model into next.
+		change := func(next []int) {
+			undo = append(undo, model)
+			if len(undo) > 4 {
+				undo = undo[1:]
+			}
+			redo = nil
+			model = next
+		}
+		for _, op := range testkit.DecodeOps(data, 8) {
+			index := op.Arg % (len(model) + 2)
+			inRange := index >= 0 && index < len(model)
+			switch op.Code {
+			case 0:
+				h.Add(op.Arg)
+				change(append(slices.Clone(model), op.Arg))
+			case 1:
+				if err := h.InsertAt(index, op.Arg); err == nil {
+					change(slices.Insert(slices.Clone(model), index, op.Arg))
+				}
+			case 2:
+				if err := h.RemoveAt(index); (err == nil) != inRange {
+					t.Fatalf("Expected RemoveAt(%d) on %v to fail: %v, got %v", index, model, !inRange, err)
+				}
+				if inRange {
+					change(slices.Delete(slices.Clone(model), index, index+1))
+				}
+			case 3:
+				if err := h.Set(index, op.Arg); err == nil {
+					next := slices.Clone(model)
+					next[index] = op.Arg
+					change(next)
+				}
+			case 4:
+				h.Clear()
+				if len(model) > 0 {
+					change([]int{})
+				}
+			case 5:
+				if err := h.Undo(); (err == nil) != (len(undo) > 0) {
+					t.Fatalf("Expected Undo to fail: %v, got %v", len(undo) == 0, err)
+				}
+				if len(undo) > 0 {
+					redo = append(redo, model)
+					model, undo = undo[len(undo)-1], undo[:len(undo)-1]
+				}
+			case 6:
+				if err := h.Redo(); (err == nil) != (len(redo) > 0) {
+					t.Fatalf("Expected Redo to fail: %v, got %v", len(redo) == 0, err)
+				}
+				if len(redo) > 0 {
+					undo = append(undo, model)
+					model, redo = redo[len(redo)-1], redo[:len(redo)-1]
+				}
+			case 7:
+				h.Reverse()
+				next := slices.Clone(model)
+				slices.Reverse(next)
+				change(next)
+			}
+			if !slices.Equal(h.Values(), model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, h.Values())
+			}
+		}
+	})
+}
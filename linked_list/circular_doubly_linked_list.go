@@ -0,0 +1,268 @@
+package linked_list
+
+import (
+	"errors"
+	"fmt"
+
+	"goCollections/collections"
+)
+
+// CircularDoublyLinkedList represents a doubly linked list whose tail links back to its head
+// and whose head links back to its tail. It keeps a cursor that can move in both directions
+// around the circle.
+type CircularDoublyLinkedList[T comparable] struct {
+	head    *DoublyLinkedListNode[T] // The first node; head.prev is the tail.
+	current *DoublyLinkedListNode[T] // The node at the cursor.
+	size    int
+}
+
+var _ collections.Collection[int] = (*CircularDoublyLinkedList[int])(nil)
+
+// NewCircularDoublyLinkedList creates and returns a new instance of CircularDoublyLinkedList.
+func NewCircularDoublyLinkedList[T comparable]() *CircularDoublyLinkedList[T] {
+	return &CircularDoublyLinkedList[T]{}
+}
+
+// Add adds a new node with the specified value at the end of the circle, just before the head.
+// If the list is empty, the new node also becomes the current node.
+func (l *CircularDoublyLinkedList[T]) Add(value T) bool {
+	n := &DoublyLinkedListNode[T]{value: value}
+	if l.head == nil {
+		n.next = n
+		n.prev = n
+		l.head = n
+		l.current = n
+	} else {
+		tail := l.head.prev
+		n.prev = tail
+		n.next = l.head
+		tail.next = n
+		l.head.prev = n
+	}
+	l.size++
+	return true
+}
+
+// unlink removes n from the circle. If n was the head or the current node,
+// they move to the following node.
+func (l *CircularDoublyLinkedList[T]) unlink(n *DoublyLinkedListNode[T]) {
+	if l.size == 1 {
+		l.Clear()
+		return
+	}
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	if n == l.head {
+		l.head = n.next
+	}
+	if n == l.current {
+		l.current = n.next
+	}
+	l.size--
+}
+
+// Remove removes the first occurrence of the specified value, starting from the head.
+// If the removed node was the current node, the cursor moves to the following node.
+// It returns false if the value is not found.
+func (l *CircularDoublyLinkedList[T]) Remove(value T) bool {
+	n := l.head
+	for i := 0; i < l.size; i++ {
+		if n.value == value {
+			l.unlink(n)
+			return true
+		}
+		n = n.next
+	}
+	return false
+}
+
+// Contains checks if the list contains a specific value.
+func (l *CircularDoublyLinkedList[T]) Contains(value T) bool {
+	n := l.head
+	for i := 0; i < l.size; i++ {
+		if n.value == value {
+			return true
+		}
+		n = n.next
+	}
+	return false
+}
+
+// Size returns the number of nodes in the list.
+func (l *CircularDoublyLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Len returns the number of nodes in the list. It is the same as Size.
+func (l *CircularDoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list is empty, false otherwise.
+func (l *CircularDoublyLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear removes all nodes from the list.
+func (l *CircularDoublyLinkedList[T]) Clear() {
+	l.head = nil
+	l.current = nil
+	l.size = 0
+}
+
+// Values returns a slice containing the values in the list, starting from the head.
+func (l *CircularDoublyLinkedList[T]) Values() []T {
+	values := make([]T, l.size)
+	n := l.head
+	for i := range values {
+		values[i] = n.value
+		n = n.next
+	}
+	return values
+}
+
+// String returns a string representation of the list, starting from the head.
+func (l *CircularDoublyLinkedList[T]) String() string {
+	str := "["
+	for _, v := range l.Values() {
+		str += fmt.Sprintf(" %v", v)
+	}
+	str += " ]"
+	return str
+}
+
+// Rotate moves the head n positions forward around the circle, or backward if n is negative.
+// It walks whichever way around the circle is shorter. The cursor is not moved.
+func (l *CircularDoublyLinkedList[T]) Rotate(n int) {
+	if l.size == 0 {
+		return
+	}
+	n %= l.size
+	if n < 0 {
+		n += l.size
+	}
+	if n <= l.size/2 {
+		for i := 0; i < n; i++ {
+			l.head = l.head.next
+		}
+	} else {
+		for i := n; i < l.size; i++ {
+			l.head = l.head.prev
+		}
+	}
+}
+
+// Current returns the value of the current node.
+// It returns an error if the list is empty.
+func (l *CircularDoublyLinkedList[T]) Current() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	return l.current.value, nil
+}
+
+// Advance moves the cursor to the next node around the circle and returns its value.
+// It returns an error if the list is empty.
+func (l *CircularDoublyLinkedList[T]) Advance() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	l.current = l.current.next
+	return l.current.value, nil
+}
+
+// Retreat moves the cursor to the previous node around the circle and returns its value.
+// It returns an error if the list is empty.
+func (l *CircularDoublyLinkedList[T]) Retreat() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	l.current = l.current.prev
+	return l.current.value, nil
+}
+
+// RemoveCurrent removes the current node and moves the cursor to the following node.
+// It returns the removed value, or an error if the list is empty.
+func (l *CircularDoublyLinkedList[T]) RemoveCurrent() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	n := l.current
+	l.unlink(n)
+	return n.value, nil
+}
+
+// RemoveEvery repeatedly counts k nodes around the circle, starting with the current node,
+// and removes the kth, until a single node remains (the Josephus problem).
+// It returns the removed values in the order they were removed; the survivor becomes the current node.
+// It returns an error if k is less than 1.
+func (l *CircularDoublyLinkedList[T]) RemoveEvery(k int) ([]T, error) {
+	if k < 1 {
+		return nil, errors.New("k must be positive")
+	}
+	removed := make([]T, 0, l.size)
+	for l.size > 1 {
+		for i := 1; i < k; i++ {
+			l.current = l.current.next
+		}
+		value, _ := l.RemoveCurrent()
+		removed = append(removed, value)
+	}
+	return removed, nil
+}
+
+// Split splits the circle in two: the nodes from index to the tail are removed and returned
+// as a new circle, and the nodes before index remain in this one.
+// The cursor of the new circle is its head; if this circle's cursor was moved, it is reset to the head.
+// It returns an error if the index is not between 1 and Size()-1.
+func (l *CircularDoublyLinkedList[T]) Split(index int) (*CircularDoublyLinkedList[T], error) {
+	if index <= 0 || index >= l.size {
+		return nil, errors.New("index out of range")
+	}
+	first := l.head
+	for i := 0; i < index; i++ {
+		first = first.next
+	}
+	cursorMoved := false
+	for n, i := first, index; i < l.size; i++ {
+		if n == l.current {
+			cursorMoved = true
+		}
+		n = n.next
+	}
+
+	last, tail := first.prev, l.head.prev
+	other := &CircularDoublyLinkedList[T]{head: first, current: first, size: l.size - index}
+	first.prev = tail
+	tail.next = first
+	last.next = l.head
+	l.head.prev = last
+	l.size = index
+	if cursorMoved {
+		l.current = l.head
+	}
+	return other, nil
+}
+
+// Merge moves the nodes of other to the end of this circle, after the tail, in constant time.
+// The other list is left empty. The cursor of this circle is not moved, unless it was empty.
+func (l *CircularDoublyLinkedList[T]) Merge(other *CircularDoublyLinkedList[T]) {
+	if other == l || other.size == 0 {
+		return
+	}
+	if l.size == 0 {
+		*l = *other
+	} else {
+		tail, otherTail := l.head.prev, other.head.prev
+		tail.next = other.head
+		other.head.prev = tail
+		otherTail.next = l.head
+		l.head.prev = otherTail
+		l.size += other.size
+	}
+	other.Clear()
+}
@@ -0,0 +1,272 @@
+package linked_list
+
+import (
+	"slices"
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestCircularDoublyLinkedListAddRemove(t *testing.T) {
+	// Create a new instance of the CircularDoublyLinkedList struct
+	list := NewCircularDoublyLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	// Test Values, Size and Contains
+	if list.String() != "[ 1 2 3 ]" {
+		t.Errorf("Expected list to be [ 1 2 3 ], got %v", list)
+	}
+	if list.Size() != 3 || !list.Contains(3) || list.Contains(4) {
+		t.Errorf("Unexpected list contents %v", list)
+	}
+
+	// Test Remove
+	if !list.Remove(1) || list.Remove(4) {
+		t.Errorf("Expected only 1 to be removed")
+	}
+	if list.String() != "[ 2 3 ]" {
+		t.Errorf("Expected list to be [ 2 3 ], got %v", list)
+	}
+	if v, _ := list.Current(); v != 2 {
+		t.Errorf("Expected current value to be 2, got %v", v)
+	}
+
+	// Test removing every node
+	list.Remove(2)
+	list.Remove(3)
+	if !list.IsEmpty() {
+		t.Errorf("Expected list to be empty")
+	}
+	if _, err := list.Current(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestCircularDoublyLinkedListRotate(t *testing.T) {
+	// Create a new CircularDoublyLinkedList
+	list := NewCircularDoublyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Add(i)
+	}
+
+	// Test rotating forward and backward
+	list.Rotate(2)
+	if list.String() != "[ 3 4 5 1 2 ]" {
+		t.Errorf("Expected list to be [ 3 4 5 1 2 ], got %v", list)
+	}
+	list.Rotate(-3)
+	if list.String() != "[ 5 1 2 3 4 ]" {
+		t.Errorf("Expected list to be [ 5 1 2 3 4 ], got %v", list)
+	}
+
+	// Test that the cursor did not move
+	if v, _ := list.Current(); v != 1 {
+		t.Errorf("Expected current value to be 1, got %v", v)
+	}
+}
+
+func TestCircularDoublyLinkedListCursor(t *testing.T) {
+	// Create a new CircularDoublyLinkedList
+	list := NewCircularDoublyLinkedList[string]()
+	list.Add("a")
+	list.Add("b")
+	list.Add("c")
+
+	// Test round-robin advancing
+	expected := []string{"b", "c", "a", "b"}
+	for _, e := range expected {
+		if v, _ := list.Advance(); v != e {
+			t.Errorf("Expected %v, got %v", e, v)
+		}
+	}
+
+	// Test RemoveCurrent
+	if v, _ := list.RemoveCurrent(); v != "b" {
+		t.Errorf("Expected removed value to be b, got %v", v)
+	}
+	if v, _ := list.Current(); v != "c" {
+		t.Errorf("Expected current value to be c, got %v", v)
+	}
+	list.Add("d")
+	if list.String() != "[ a c d ]" {
+		t.Errorf("Expected list to be [ a c d ], got %v", list)
+	}
+}
+
+func TestCircularDoublyLinkedListRemoveEvery(t *testing.T) {
+	// Create the classic Josephus circle of 7 people with k = 3
+	list := NewCircularDoublyLinkedList[int]()
+	for i := 1; i <= 7; i++ {
+		list.Add(i)
+	}
+
+	// Test the elimination order and survivor
+	removed, err := list.RemoveEvery(3)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expected := []int{3, 6, 2, 7, 5, 1}
+	for i, v := range removed {
+		if v != expected[i] {
+			t.Errorf("Expected removed value %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if v, _ := list.Current(); v != 4 || list.Size() != 1 {
+		t.Errorf("Expected survivor to be 4, got %v", v)
+	}
+
+	// Test an invalid k
+	if _, err := list.RemoveEvery(0); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestCircularDoublyLinkedListSplitMerge(t *testing.T) {
+	// Create a new CircularDoublyLinkedList
+	list := NewCircularDoublyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Add(i)
+	}
+
+	// Test Split
+	other, err := list.Split(2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if list.String() != "[ 1 2 ]" || other.String() != "[ 3 4 5 ]" {
+		t.Errorf("Expected [ 1 2 ] and [ 3 4 5 ], got %v and %v", list, other)
+	}
+	if v, _ := other.Current(); v != 3 {
+		t.Errorf("Expected current value of the new circle to be 3, got %v", v)
+	}
+	if v, _ := list.Advance(); v != 2 {
+		t.Errorf("Expected 2, got %v", v)
+	}
+	if v, _ := list.Advance(); v != 1 {
+		t.Errorf("Expected the circle to wrap around to 1, got %v", v)
+	}
+	if _, err := list.Split(2); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test Merge
+	list.Merge(other)
+	if list.String() != "[ 1 2 3 4 5 ]" || list.Size() != 5 {
+		t.Errorf("Expected [ 1 2 3 4 5 ], got %v", list)
+	}
+	if !other.IsEmpty() {
+		t.Errorf("Expected the merged list to be empty")
+	}
+	if v, _ := list.Current(); v != 1 {
+		t.Errorf("Expected current value to be 1, got %v", v)
+	}
+	list.Rotate(-1)
+	if list.String() != "[ 5 1 2 3 4 ]" {
+		t.Errorf("Expected [ 5 1 2 3 4 ], got %v", list)
+	}
+}
+
+func TestCircularDoublyLinkedListRetreat(t *testing.T) {
+	// Create a new CircularDoublyLinkedList
+	list := NewCircularDoublyLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	// Test moving the cursor backward around the circle
+	expected := []int{3, 2, 1, 3}
+	for _, e := range expected {
+		if v, _ := list.Retreat(); v != e {
+			t.Errorf("Expected %v, got %v", e, v)
+		}
+	}
+}
+
+func TestCircularDoublyLinkedListConformance(t *testing.T) {
+	// Test that CircularDoublyLinkedList behaves like every other Collection
+	testkit.TestCollection(t, func(values ...int) collections.Collection[int] {
+		l := NewCircularDoublyLinkedList[int]()
+		for _, v := range values {
+			l.Add(v)
+		}
+		return l
+	}, func(i int) int { return i }, testkit.Ordered[int]())
+}
+
+func FuzzCircularDoublyLinkedList(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 3, 0, 1, 2, 4, 0})
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 2, 1, 5, 0, 1, 3, 4, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a CircularDoublyLinkedList and to a slice with the index of the current value,
+		// and compare them after every operation
+		l := NewCircularDoublyLinkedList[int]()
+		var model []int
+		current := 0
+		for _, op := range testkit.DecodeOps(data, 7) {
+			switch op.Code {
+			case 0:
+				l.Add(op.Arg)
+				model = append(model, op.Arg)
+			case 1:
+				i := slices.Index(model, op.Arg)
+				if l.Remove(op.Arg) != (i >= 0) {
+					t.Fatalf("Expected Remove(%d) on %v to return %v", op.Arg, model, i >= 0)
+				}
+				if i >= 0 {
+					model = slices.Delete(model, i, i+1)
+					if i < current {
+						current--
+					}
+				}
+			case 2:
+				l.Rotate(op.Arg)
+				if len(model) > 0 {
+					n := (op.Arg%len(model) + len(model)) % len(model)
+					model = append(model[n:], model[:n]...)
+					current = (current - n + len(model)) % len(model)
+				}
+			case 3:
+				v, err := l.Advance()
+				if len(model) > 0 {
+					current = (current + 1) % len(model)
+				}
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[current] {
+					t.Fatalf("Expected Advance on %v to match, got %v (%v)", model, v, err)
+				}
+			case 4:
+				v, err := l.RemoveCurrent()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[current] {
+					t.Fatalf("Expected RemoveCurrent on %v to match, got %v (%v)", model, v, err)
+				}
+				if len(model) > 0 {
+					model = slices.Delete(model, current, current+1)
+				}
+			case 5:
+				v, err := l.Current()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[current] {
+					t.Fatalf("Expected Current on %v to be %v, got %v (%v)", model, current, v, err)
+				}
+			case 6:
+				v, err := l.Retreat()
+				if len(model) > 0 {
+					current = (current + len(model) - 1) % len(model)
+				}
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[current] {
+					t.Fatalf("Expected Retreat on %v to match, got %v (%v)", model, v, err)
+				}
+			}
+			if current >= len(model) {
+				current = 0
+			}
+			if !slices.Equal(l.Values(), model) || l.Size() != len(model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, l.Values())
+			}
+			if v, err := l.Current(); len(model) > 0 && (err != nil || v != model[current]) {
+				t.Fatalf("Expected current value %v after %+v, got %v (%v)", model[current], op, v, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,257 @@
+package linked_list
+
+import (
+	"errors"
+	"fmt"
+
+	"goCollections/collections"
+)
+
+// CircularLinkedList represents a singly linked list whose tail links back to its head.
+// Besides the head, it keeps a cursor that can be advanced around the circle,
+// which makes it suitable for round-robin scheduling.
+type CircularLinkedList[T comparable] struct {
+	tail   *Node[T] // The last node; tail.next is the head.
+	cursor *Node[T] // The node before the cursor; cursor.next is the current node.
+	size   int      // Number of nodes in the list
+}
+
+var _ collections.Collection[int] = (*CircularLinkedList[int])(nil)
+
+// NewCircularLinkedList creates and returns a new instance of CircularLinkedList.
+func NewCircularLinkedList[T comparable]() *CircularLinkedList[T] {
+	return &CircularLinkedList[T]{}
+}
+
+// Add adds a new node with the specified value at the end of the circle, just before the head.
+// If the list is empty, the new node also becomes the current node.
+func (l *CircularLinkedList[T]) Add(value T) bool {
+	n := &Node[T]{value: value}
+	if l.tail == nil {
+		n.next = n
+		l.cursor = n
+	} else {
+		n.next = l.tail.next
+		l.tail.next = n
+		if l.cursor == l.tail {
+			l.cursor = n
+		}
+	}
+	l.tail = n
+	l.size++
+	return true
+}
+
+// removeAfter removes the node following prev from the circle.
+func (l *CircularLinkedList[T]) removeAfter(prev *Node[T]) *Node[T] {
+	n := prev.next
+	if l.size == 1 {
+		l.Clear()
+		return n
+	}
+	prev.next = n.next
+	if n == l.tail {
+		l.tail = prev
+	}
+	if n == l.cursor {
+		l.cursor = prev
+	}
+	l.size--
+	return n
+}
+
+// Remove removes the first occurrence of the specified value, starting from the head.
+// If the removed node was the current node, the cursor moves to the following node.
+// It returns false if the value is not found.
+func (l *CircularLinkedList[T]) Remove(value T) bool {
+	prev := l.tail
+	for i := 0; i < l.size; i++ {
+		if prev.next.value == value {
+			l.removeAfter(prev)
+			return true
+		}
+		prev = prev.next
+	}
+	return false
+}
+
+// Contains checks if the list contains a specific value.
+func (l *CircularLinkedList[T]) Contains(value T) bool {
+	n := l.head()
+	for i := 0; i < l.size; i++ {
+		if n.value == value {
+			return true
+		}
+		n = n.next
+	}
+	return false
+}
+
+// head returns the first node of the list, or nil if the list is empty.
+func (l *CircularLinkedList[T]) head() *Node[T] {
+	if l.tail == nil {
+		return nil
+	}
+	return l.tail.next
+}
+
+// Size returns the number of nodes in the list.
+func (l *CircularLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Len returns the number of nodes in the list. It is the same as Size.
+func (l *CircularLinkedList[T]) Len() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list is empty, false otherwise.
+func (l *CircularLinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear removes all nodes from the list.
+func (l *CircularLinkedList[T]) Clear() {
+	l.tail = nil
+	l.cursor = nil
+	l.size = 0
+}
+
+// Values returns a slice containing the values in the list, starting from the head.
+func (l *CircularLinkedList[T]) Values() []T {
+	values := make([]T, l.size)
+	n := l.head()
+	for i := range values {
+		values[i] = n.value
+		n = n.next
+	}
+	return values
+}
+
+// String returns a string representation of the list, starting from the head.
+func (l *CircularLinkedList[T]) String() string {
+	str := "["
+	for _, v := range l.Values() {
+		str += fmt.Sprintf(" %v", v)
+	}
+	str += " ]"
+	return str
+}
+
+// Rotate moves the head n positions forward around the circle, or backward if n is negative.
+// The cursor is not moved.
+func (l *CircularLinkedList[T]) Rotate(n int) {
+	if l.size == 0 {
+		return
+	}
+	n %= l.size
+	if n < 0 {
+		n += l.size
+	}
+	for i := 0; i < n; i++ {
+		l.tail = l.tail.next
+	}
+}
+
+// Current returns the value of the current node.
+// It returns an error if the list is empty.
+func (l *CircularLinkedList[T]) Current() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	return l.cursor.next.value, nil
+}
+
+// Advance moves the cursor to the next node around the circle and returns its value.
+// It returns an error if the list is empty.
+func (l *CircularLinkedList[T]) Advance() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	l.cursor = l.cursor.next
+	return l.cursor.next.value, nil
+}
+
+// RemoveCurrent removes the current node and moves the cursor to the following node.
+// It returns the removed value, or an error if the list is empty.
+func (l *CircularLinkedList[T]) RemoveCurrent() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	return l.removeAfter(l.cursor).value, nil
+}
+
+// RemoveEvery repeatedly counts k nodes around the circle, starting with the current node,
+// and removes the kth, until a single node remains (the Josephus problem).
+// It returns the removed values in the order they were removed; the survivor becomes the current node.
+// It returns an error if k is less than 1.
+func (l *CircularLinkedList[T]) RemoveEvery(k int) ([]T, error) {
+	if k < 1 {
+		return nil, errors.New("k must be positive")
+	}
+	removed := make([]T, 0, l.size)
+	for l.size > 1 {
+		for i := 1; i < k; i++ {
+			l.cursor = l.cursor.next
+		}
+		removed = append(removed, l.removeAfter(l.cursor).value)
+	}
+	return removed, nil
+}
+
+// Split splits the circle in two: the nodes from index to the tail are removed and returned
+// as a new circle, and the nodes before index remain in this one.
+// The cursor of the new circle is its head; if this circle's cursor was moved, it is reset to the head.
+// It returns an error if the index is not between 1 and Size()-1.
+func (l *CircularLinkedList[T]) Split(index int) (*CircularLinkedList[T], error) {
+	if index <= 0 || index >= l.size {
+		return nil, errors.New("index out of range")
+	}
+	last := l.tail
+	for i := 0; i < index; i++ {
+		last = last.next
+	}
+	cursorMoved := false
+	for n, i := last, index; i < l.size; i++ {
+		n = n.next
+		if n == l.cursor.next {
+			cursorMoved = true
+		}
+	}
+
+	other := &CircularLinkedList[T]{tail: l.tail, size: l.size - index}
+	head := l.tail.next
+	l.tail.next = last.next
+	other.cursor = other.tail
+	last.next = head
+	l.tail = last
+	l.size = index
+	if cursorMoved || l.cursor == other.tail {
+		l.cursor = l.tail
+	}
+	return other, nil
+}
+
+// Merge moves the nodes of other to the end of this circle, after the tail, in constant time.
+// The other list is left empty. The cursor of this circle is not moved, unless it was empty.
+func (l *CircularLinkedList[T]) Merge(other *CircularLinkedList[T]) {
+	if other == l || other.size == 0 {
+		return
+	}
+	if l.size == 0 {
+		*l = *other
+	} else {
+		head := l.tail.next
+		l.tail.next = other.tail.next
+		other.tail.next = head
+		if l.cursor == l.tail {
+			l.cursor = other.tail
+		}
+		l.tail = other.tail
+		l.size += other.size
+	}
+	other.Clear()
+}
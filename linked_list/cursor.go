@@ -0,0 +1,176 @@
+package linked_list
+
+import (
+	"errors"
+
+	"goCollections/collections"
+)
+
+// ErrConcurrentModification is returned by Cursor methods when the list was structurally
+// modified through anything other than the cursor itself after the cursor was created.
+var ErrConcurrentModification = errors.New("list was modified outside of the cursor")
+
+// Cursor points at a position in a DoublyLinkedList and edits the list at that position in constant time.
+// Besides the nodes of the list, a cursor can point at a "ghost" position that sits between the tail
+// and the head: moving forward from the tail or backward from the head reaches the ghost, and moving
+// from the ghost wraps around to the other end.
+//
+// A cursor fails fast: once the list has been structurally modified by anything other than the cursor,
+// every method returns ErrConcurrentModification.
+type Cursor[T any] struct {
+	list     *DoublyLinkedList[T]
+	node     *DoublyLinkedListNode[T] // The current node, or nil at the ghost position.
+	index    int                      // The index of the current node, or the size of the list at the ghost position.
+	modCount int                      // The modification count of the list the cursor expects.
+}
+
+// Front returns a cursor pointing at the head of the doubly linked list,
+// or at the ghost position if the list is empty.
+func (l *DoublyLinkedList[T]) Front() *Cursor[T] {
+	return &Cursor[T]{list: l, node: l.head, modCount: l.modCount}
+}
+
+// Back returns a cursor pointing at the tail of the doubly linked list,
+// or at the ghost position if the list is empty.
+func (l *DoublyLinkedList[T]) Back() *Cursor[T] {
+	index := l.size - 1
+	if l.tail == nil {
+		index = 0
+	}
+	return &Cursor[T]{list: l, node: l.tail, index: index, modCount: l.modCount}
+}
+
+// check returns ErrConcurrentModification if the list was modified outside of the cursor.
+func (c *Cursor[T]) check() error {
+	if c.modCount != c.list.modCount {
+		return ErrConcurrentModification
+	}
+	return nil
+}
+
+// Valid checks if the cursor points at a node of the list rather than the ghost position,
+// and the list has not been modified outside of the cursor.
+func (c *Cursor[T]) Valid() bool {
+	return c.node != nil && c.check() == nil
+}
+
+// Index returns the index of the node the cursor points at, or the size of the list at the ghost position.
+func (c *Cursor[T]) Index() int {
+	return c.index
+}
+
+// Next moves the cursor to the next node. Moving past the tail reaches the ghost position,
+// and moving from the ghost position reaches the head.
+func (c *Cursor[T]) Next() error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	if c.node == nil {
+		c.node = c.list.head
+		c.index = 0
+	} else {
+		c.node = c.node.next
+		c.index++
+	}
+	return nil
+}
+
+// Prev moves the cursor to the previous node. Moving before the head reaches the ghost position,
+// and moving from the ghost position reaches the tail.
+func (c *Cursor[T]) Prev() error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	if c.node == nil {
+		c.node = c.list.tail
+		c.index = c.list.size - 1
+	} else {
+		c.node = c.node.prev
+		c.index--
+	}
+	if c.node == nil {
+		c.index = c.list.size
+	}
+	return nil
+}
+
+// Value returns the value of the node the cursor points at.
+// It returns an error if the cursor is at the ghost position.
+func (c *Cursor[T]) Value() (T, error) {
+	if err := c.check(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if c.node == nil {
+		var zero T
+		return zero, errors.New("index out of range")
+	}
+	return c.node.value, nil
+}
+
+// Set replaces the value of the node the cursor points at.
+// It returns an error if the cursor is at the ghost position.
+func (c *Cursor[T]) Set(value T) error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	if c.node == nil {
+		return errors.New("index out of range")
+	}
+	old := c.node.value
+	c.node.value = value
+	c.list.observers.Emit(collections.Event[T]{Kind: collections.Updated, Index: c.index, Value: value, Old: old})
+	return nil
+}
+
+// InsertBefore inserts a new node with the specified value before the cursor.
+// At the ghost position, the node is inserted at the tail. The cursor does not move.
+func (c *Cursor[T]) InsertBefore(value T) error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	node := &DoublyLinkedListNode[T]{value: value}
+	if c.node == nil {
+		c.list.link(node, c.list.tail, nil, c.index)
+	} else {
+		c.list.link(node, c.node.prev, c.node, c.index)
+	}
+	c.index++
+	c.modCount = c.list.modCount
+	return nil
+}
+
+// InsertAfter inserts a new node with the specified value after the cursor.
+// At the ghost position, the node is inserted at the head. The cursor does not move.
+func (c *Cursor[T]) InsertAfter(value T) error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	node := &DoublyLinkedListNode[T]{value: value}
+	if c.node == nil {
+		c.list.link(node, nil, c.list.head, 0)
+		c.index++
+	} else {
+		c.list.link(node, c.node, c.node.next, c.index+1)
+	}
+	c.modCount = c.list.modCount
+	return nil
+}
+
+// Remove removes the node the cursor points at and moves the cursor to the next node.
+// It returns the removed value, or an error if the cursor is at the ghost position.
+func (c *Cursor[T]) Remove() (T, error) {
+	if err := c.check(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if c.node == nil {
+		var zero T
+		return zero, errors.New("index out of range")
+	}
+	node := c.node
+	c.node = node.next
+	c.list.unlink(node, c.index)
+	c.modCount = c.list.modCount
+	return node.value, nil
+}
@@ -0,0 +1,139 @@
+package linked_list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCursorTraversal(t *testing.T) {
+	// Create a new DoublyLinkedList
+	list := NewDoublyLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	// Test walking forward from the front
+	expected := 1
+	for c := list.Front(); c.Valid(); c.Next() {
+		if v, _ := c.Value(); v != expected {
+			t.Errorf("Expected %d, got %v", expected, v)
+		}
+		if c.Index() != expected-1 {
+			t.Errorf("Expected index %d, got %d", expected-1, c.Index())
+		}
+		expected++
+	}
+	if expected != 4 {
+		t.Errorf("Expected to visit 3 nodes, visited %d", expected-1)
+	}
+
+	// Test walking backward from the back, through the ghost position
+	c := list.Back()
+	c.Prev()
+	c.Prev()
+	c.Prev()
+	if c.Valid() || c.Index() != 3 {
+		t.Errorf("Expected cursor to be at the ghost position")
+	}
+	if _, err := c.Value(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	c.Prev()
+	if v, _ := c.Value(); v != 3 {
+		t.Errorf("Expected the cursor to wrap around to 3, got %v", v)
+	}
+	c.Next()
+	c.Next()
+	if v, _ := c.Value(); v != 1 {
+		t.Errorf("Expected the cursor to wrap around to 1, got %v", v)
+	}
+}
+
+func TestCursorMutation(t *testing.T) {
+	// Create a new DoublyLinkedList
+	list := NewDoublyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		list.Add(i)
+	}
+
+	// Test doubling every value and removing odd ones in a single pass
+	c := list.Front()
+	for c.Valid() {
+		v, _ := c.Value()
+		if v%2 == 1 {
+			c.Remove()
+			continue
+		}
+		c.Set(v * 10)
+		c.InsertAfter(v*10 + 1)
+		c.Next()
+		c.Next()
+	}
+	expected := []int{20, 21, 40, 41}
+	values := list.Values()
+	if len(values) != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+	for i, v := range values {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+
+	// Test inserting at the ghost position
+	c.InsertBefore(50)
+	c.InsertAfter(0)
+	if list.Head().Value() != 0 || list.Tail().Value() != 50 || list.Size() != 6 {
+		t.Errorf("Expected list to be [0 20 21 40 41 50], got %v", list.Values())
+	}
+	if c.Index() != 6 {
+		t.Errorf("Expected ghost index to be 6, got %d", c.Index())
+	}
+
+	// Test InsertBefore keeps the cursor on the same node
+	c = list.Front()
+	c.Next()
+	c.InsertBefore(10)
+	if v, _ := c.Value(); v != 20 || c.Index() != 2 {
+		t.Errorf("Expected cursor at 20 with index 2, got %v at %d", v, c.Index())
+	}
+	if _, err := list.Front().Remove(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestCursorConcurrentModification(t *testing.T) {
+	// Create a new DoublyLinkedList with two cursors
+	list := NewDoublyLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	c1 := list.Front()
+	c2 := list.Front()
+
+	// Test that changing a value is not a structural modification
+	list.Set(0, 10)
+	if v, err := c1.Value(); err != nil || v != 10 {
+		t.Errorf("Expected 10, got %v (%v)", v, err)
+	}
+
+	// Test that a structural modification through one cursor invalidates the other
+	c1.Remove()
+	if _, err := c2.Value(); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Expected ErrConcurrentModification, got %v", err)
+	}
+	if err := c2.Next(); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Expected ErrConcurrentModification, got %v", err)
+	}
+	if c2.Valid() {
+		t.Errorf("Expected cursor to be invalid")
+	}
+
+	// Test that a modification through the list invalidates the cursor
+	list.Add(3)
+	if _, err := c1.Remove(); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Expected ErrConcurrentModification, got %v", err)
+	}
+	if err := c1.InsertAfter(4); !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("Expected ErrConcurrentModification, got %v", err)
+	}
+}
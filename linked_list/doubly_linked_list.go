@@ -1,119 +1,331 @@
 package linked_list
 
-import "errors"
+import (
+	"errors"
+
+	"goCollections/collections"
+)
 
 // DoublyLinkedListNode represents a node in a doubly linked list.
-type DoublyLinkedListNode struct {
-	value interface{}
-	next  *DoublyLinkedListNode
-	prev  *DoublyLinkedListNode
+type DoublyLinkedListNode[T any] struct {
+	value T
+	next  *DoublyLinkedListNode[T]
+	prev  *DoublyLinkedListNode[T]
+	owner *listOwner // Identifies the list the node belongs to, or nil once it has been removed.
 }
 
-// DoublyLinkedList represents a doubly linked list data structure.
-type DoublyLinkedList struct {
-	head *DoublyLinkedListNode
-	tail *DoublyLinkedListNode
-	size int
+// listOwner identifies a DoublyLinkedList for ownership checks on its nodes.
+// Concat and MergeSorted forward the owner of the absorbed list to the owner of the
+// receiving list instead of retagging every node, so a node's list is found by following
+// the forward pointers, which are compressed along the way.
+type listOwner struct {
+	forward *listOwner
 }
 
-// NewDoublyLinkedList creates and returns a new instance of DoublyLinkedList.
-func NewDoublyLinkedList() *DoublyLinkedList {
-	return &DoublyLinkedList{
-		head: nil,
-		tail: nil,
-		size: 0,
+// resolve returns the owner at the end of the forward chain.
+func (o *listOwner) resolve() *listOwner {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
 	}
+	return root
+}
+
+// Value returns the value stored in the node.
+func (n *DoublyLinkedListNode[T]) Value() T {
+	return n.value
+}
+
+// Next returns the next node in the list, or nil if the node is the tail.
+func (n *DoublyLinkedListNode[T]) Next() *DoublyLinkedListNode[T] {
+	return n.next
+}
+
+// Prev returns the previous node in the list, or nil if the node is the head.
+func (n *DoublyLinkedListNode[T]) Prev() *DoublyLinkedListNode[T] {
+	return n.prev
+}
+
+// DoublyLinkedList represents a doubly linked list data structure holding values of type T.
+type DoublyLinkedList[T any] struct {
+	head     *DoublyLinkedListNode[T]
+	tail     *DoublyLinkedListNode[T]
+	size     int
+	modCount int          // Incremented on every structural modification, so cursors can detect them.
+	owner    *listOwner   // Shared by the nodes of the list; created on first use.
+	equal    EqualFunc[T] // Compares values; nil means == on the dynamic values.
+
+	observers collections.Observers[T] // The subscribers to changes of the list.
+}
+
+var _ collections.List[int] = (*DoublyLinkedList[int])(nil)
+
+// NewDoublyLinkedList creates and returns a new instance of DoublyLinkedList for comparable values.
+// Values are compared with == unless WithEqual is passed.
+func NewDoublyLinkedList[T comparable](opts ...Option[T]) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{
+		head:  nil,
+		tail:  nil,
+		size:  0,
+		equal: newConfig(opts, equalComparable[T]).equal,
+	}
+}
+
+// NewDoublyLinkedListFunc creates and returns a new instance of DoublyLinkedList for values of any type,
+// such as slices or structs with slice fields, which are compared with equal.
+func NewDoublyLinkedListFunc[T any](equal EqualFunc[T]) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{equal: equal}
 }
 
 // InsertAt inserts a new node at the specified index in the doubly linked list.
-func (l *DoublyLinkedList) InsertAt(index int, value interface{}) error {
+func (l *DoublyLinkedList[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > l.Size() {
 		return errors.New("index out of range")
 	}
 
-	newNode := &DoublyLinkedListNode{value: value}
+	newNode := &DoublyLinkedListNode[T]{value: value}
 
-	if l.Size() == 0 {
-		l.head = newNode
-		l.tail = newNode
-	} else if index == 0 {
-		newNode.next = l.head
-		l.head.prev = newNode
-		l.head = newNode
-	} else if index == l.Size() {
-		l.tail.next = newNode
-		newNode.prev = l.tail
-		l.tail = newNode
+	if index == l.Size() {
+		l.link(newNode, l.tail, nil, index)
 	} else {
-		currentNode := l.head
-		for i := 0; i < index-1; i++ {
-			currentNode = currentNode.next
-		}
-		newNode.next = currentNode.next
-		newNode.prev = currentNode
-		currentNode.next.prev = newNode
-		currentNode.next = newNode
+		currentNode := l.nodeAt(index)
+		l.link(newNode, currentNode.prev, currentNode, index)
 	}
 
-	l.size++
-
 	return nil
 }
 
 // RemoveAt removes a node at the specified index in the doubly linked list.
-func (l *DoublyLinkedList) RemoveAt(index int) error {
+func (l *DoublyLinkedList[T]) RemoveAt(index int) error {
 	if index < 0 || index >= l.Size() {
 		return errors.New("index out of range")
 	}
 
-	if l.Size() == 1 {
-		l.head = nil
-		l.tail = nil
-	} else if index == 0 {
-		l.head = l.head.next
-		l.head.prev = nil
-	} else if index == l.Size()-1 {
-		l.tail = l.tail.prev
-		l.tail.next = nil
-	} else {
+	l.unlink(l.nodeAt(index), index)
+
+	return nil
+}
+
+// nodeAt returns the node at the specified index, which must be in range.
+// It walks from the head or the tail, whichever is closer to the index.
+func (l *DoublyLinkedList[T]) nodeAt(index int) *DoublyLinkedListNode[T] {
+	if index < l.size/2 {
 		currentNode := l.head
-		for i := 0; i < index-1; i++ {
+		for i := 0; i < index; i++ {
 			currentNode = currentNode.next
 		}
-		currentNode.next = currentNode.next.next
-		currentNode.next.prev = currentNode
+		return currentNode
 	}
+	currentNode := l.tail
+	for i := l.size - 1; i > index; i-- {
+		currentNode = currentNode.prev
+	}
+	return currentNode
+}
+
+// id returns the owner shared by the nodes of the list.
+func (l *DoublyLinkedList[T]) id() *listOwner {
+	if l.owner == nil {
+		l.owner = &listOwner{}
+	}
+	return l.owner
+}
+
+// owns checks if node belongs to the list.
+func (l *DoublyLinkedList[T]) owns(node *DoublyLinkedListNode[T]) bool {
+	return node != nil && node.owner != nil && node.owner.resolve() == l.owner
+}
+
+// eventIndex returns the index of node, which must belong to the list, for change events.
+// Finding it takes linear time, so it returns -1 without looking when nobody is subscribed.
+func (l *DoublyLinkedList[T]) eventIndex(node *DoublyLinkedListNode[T]) int {
+	if !l.observers.Active() {
+		return -1
+	}
+	index := 0
+	for n := l.head; n != node; n = n.next {
+		index++
+	}
+	return index
+}
 
+// emit delivers a change event for value at index to the subscribers of the list.
+func (l *DoublyLinkedList[T]) emit(kind collections.EventKind, index int, value T) {
+	l.observers.Emit(collections.Event[T]{Kind: kind, Index: index, Value: value})
+}
+
+// link inserts node between prev and next, which must be adjacent nodes of the list,
+// and reports it as added at index. A nil prev inserts at the head and a nil next inserts at the tail.
+func (l *DoublyLinkedList[T]) link(node, prev, next *DoublyLinkedListNode[T], index int) {
+	node.prev = prev
+	node.next = next
+	node.owner = l.id()
+	if prev == nil {
+		l.head = node
+	} else {
+		prev.next = node
+	}
+	if next == nil {
+		l.tail = node
+	} else {
+		next.prev = node
+	}
+	l.size++
+	l.modCount++
+	l.emit(collections.Added, index, node.value)
+}
+
+// unlink removes node, which is at index, from the list.
+func (l *DoublyLinkedList[T]) unlink(node *DoublyLinkedListNode[T], index int) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next = nil
+	node.prev = nil
+	node.owner = nil
 	l.size--
+	l.modCount++
+	l.emit(collections.Removed, index, node.value)
+}
+
+// PushFront inserts a new node with the specified value at the head of the doubly linked list
+// and returns the new node.
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyLinkedListNode[T] {
+	node := &DoublyLinkedListNode[T]{value: value}
+	l.link(node, nil, l.head, 0)
+	return node
+}
 
+// PushBack inserts a new node with the specified value at the tail of the doubly linked list
+// and returns the new node.
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyLinkedListNode[T] {
+	node := &DoublyLinkedListNode[T]{value: value}
+	l.link(node, l.tail, nil, l.size)
+	return node
+}
+
+// InsertBefore inserts a new node with the specified value immediately before mark
+// and returns the new node.
+// It returns an error if mark does not belong to the list.
+func (l *DoublyLinkedList[T]) InsertBefore(mark *DoublyLinkedListNode[T], value T) (*DoublyLinkedListNode[T], error) {
+	if !l.owns(mark) {
+		return nil, errors.New("node does not belong to the list")
+	}
+	node := &DoublyLinkedListNode[T]{value: value}
+	l.link(node, mark.prev, mark, l.eventIndex(mark))
+	return node, nil
+}
+
+// InsertAfter inserts a new node with the specified value immediately after mark
+// and returns the new node.
+// It returns an error if mark does not belong to the list.
+func (l *DoublyLinkedList[T]) InsertAfter(mark *DoublyLinkedListNode[T], value T) (*DoublyLinkedListNode[T], error) {
+	if !l.owns(mark) {
+		return nil, errors.New("node does not belong to the list")
+	}
+	node := &DoublyLinkedListNode[T]{value: value}
+	l.link(node, mark, mark.next, l.eventIndex(mark)+1)
+	return node, nil
+}
+
+// RemoveNode removes node from the doubly linked list in constant time.
+// It returns an error if node does not belong to the list.
+func (l *DoublyLinkedList[T]) RemoveNode(node *DoublyLinkedListNode[T]) error {
+	if !l.owns(node) {
+		return errors.New("node does not belong to the list")
+	}
+	l.unlink(node, l.eventIndex(node))
+	return nil
+}
+
+// MoveToFront moves node to the head of the doubly linked list in constant time.
+// Subscribers receive the move as a batch of a Removed and an Added event.
+// It returns an error if node does not belong to the list.
+func (l *DoublyLinkedList[T]) MoveToFront(node *DoublyLinkedListNode[T]) error {
+	if !l.owns(node) {
+		return errors.New("node does not belong to the list")
+	}
+	if l.head != node {
+		l.observers.Batch(func() {
+			l.unlink(node, l.eventIndex(node))
+			l.link(node, nil, l.head, 0)
+		})
+	}
+	return nil
+}
+
+// MoveToBack moves node to the tail of the doubly linked list in constant time.
+// Subscribers receive the move as a batch of a Removed and an Added event.
+// It returns an error if node does not belong to the list.
+func (l *DoublyLinkedList[T]) MoveToBack(node *DoublyLinkedListNode[T]) error {
+	if !l.owns(node) {
+		return errors.New("node does not belong to the list")
+	}
+	if l.tail != node {
+		l.observers.Batch(func() {
+			l.unlink(node, l.eventIndex(node))
+			l.link(node, l.tail, nil, l.size)
+		})
+	}
 	return nil
 }
 
 // Size returns the number of elements in the doubly linked list.
-func (l *DoublyLinkedList) Size() int {
+func (l *DoublyLinkedList[T]) Size() int {
+	return l.size
+}
+
+// Len returns the number of nodes in the doubly linked list. It is the same as Size.
+func (l *DoublyLinkedList[T]) Len() int {
 	return l.size
 }
 
 // IsEmpty returns true if the doubly linked list is empty, otherwise returns false.
-func (l *DoublyLinkedList) IsEmpty() bool {
+func (l *DoublyLinkedList[T]) IsEmpty() bool {
 	return l.Size() == 0
 }
 
 // Head returns the head node of the doubly linked list.
-func (l *DoublyLinkedList) Head() *DoublyLinkedListNode {
+func (l *DoublyLinkedList[T]) Head() *DoublyLinkedListNode[T] {
 	return l.head
 }
 
 // Tail returns the tail node of the doubly linked list.
-func (l *DoublyLinkedList) Tail() *DoublyLinkedListNode {
+func (l *DoublyLinkedList[T]) Tail() *DoublyLinkedListNode[T] {
 	return l.tail
 }
 
 // Search returns the index of the first occurrence of the specified value in the doubly linked list, otherwise returns -1.
-func (l *DoublyLinkedList) Search(value interface{}) int {
+func (l *DoublyLinkedList[T]) Search(value T) int {
+	return l.IndexFunc(func(v T) bool {
+		return l.equal.equals(v, value)
+	})
+}
+
+// IndexOf returns the index of the first occurrence of the specified value in the doubly linked list,
+// otherwise returns -1. It is the same as Search.
+func (l *DoublyLinkedList[T]) IndexOf(value T) int {
+	return l.Search(value)
+}
+
+// IndexFunc returns the index of the first value in the doubly linked list for which match returns true,
+// otherwise returns -1.
+func (l *DoublyLinkedList[T]) IndexFunc(match func(value T) bool) int {
 	currentNode := l.head
 	for i := 0; i < l.Size(); i++ {
-		if currentNode.value == value {
+		if match(currentNode.value) {
 			return i
 		}
 		currentNode = currentNode.next
@@ -121,9 +333,22 @@ func (l *DoublyLinkedList) Search(value interface{}) int {
 	return -1
 }
 
+// LastIndexOf returns the index of the last occurrence of the specified value in the doubly linked list,
+// searching from the tail, otherwise returns -1.
+func (l *DoublyLinkedList[T]) LastIndexOf(value T) int {
+	currentNode := l.tail
+	for i := l.Size() - 1; i >= 0; i-- {
+		if l.equal.equals(currentNode.value, value) {
+			return i
+		}
+		currentNode = currentNode.prev
+	}
+	return -1
+}
+
 // Values returns a slice of all values in the doubly linked list.
-func (l *DoublyLinkedList) Values() []interface{} {
-	values := make([]interface{}, l.Size())
+func (l *DoublyLinkedList[T]) Values() []T {
+	values := make([]T, l.Size())
 	currentNode := l.head
 	for i := 0; i < l.Size(); i++ {
 		values[i] = currentNode.value
@@ -134,57 +359,432 @@ func (l *DoublyLinkedList) Values() []interface{} {
 
 // Add adds a new node with the specified value to the end of the doubly linked list.
 // The value parameter represents the value to be added to the list.
-func (l *DoublyLinkedList) Add(value interface{}) {
+func (l *DoublyLinkedList[T]) Add(value T) {
 	l.InsertAt(l.Size(), value)
 }
 
 // Remove removes the first occurrence of the specified value from the doubly linked list.
 // If the value is not found, it returns an error.
-func (l *DoublyLinkedList) Remove(value interface{}) error {
-	index := l.Search(value)
-	if index == -1 {
-		return errors.New("value not found")
+func (l *DoublyLinkedList[T]) Remove(value T) error {
+	return l.RemoveFunc(func(v T) bool {
+		return l.equal.equals(v, value)
+	})
+}
+
+// RemoveFunc removes the first node for whose value match returns true.
+// If there is no such node, it returns an error.
+func (l *DoublyLinkedList[T]) RemoveFunc(match func(value T) bool) error {
+	for currentNode, i := l.head, 0; currentNode != nil; currentNode, i = currentNode.next, i+1 {
+		if match(currentNode.value) {
+			l.unlink(currentNode, i)
+			return nil
+		}
+	}
+	return errors.New("value not found")
+}
+
+// RemoveAllFunc removes every node for whose value match returns true.
+// Subscribers receive the removals as a single batch.
+// It returns the number of removed nodes.
+func (l *DoublyLinkedList[T]) RemoveAllFunc(match func(value T) bool) int {
+	removed := 0
+	l.observers.Batch(func() {
+		for currentNode, i := l.head, 0; currentNode != nil; {
+			next := currentNode.next
+			if match(currentNode.value) {
+				l.unlink(currentNode, i)
+				removed++
+			} else {
+				i++
+			}
+			currentNode = next
+		}
+	})
+	return removed
+}
+
+// RemoveLast removes the last occurrence of the specified value from the doubly linked list,
+// searching from the tail.
+// If the value is not found, it returns an error.
+func (l *DoublyLinkedList[T]) RemoveLast(value T) error {
+	for currentNode, i := l.tail, l.size-1; currentNode != nil; currentNode, i = currentNode.prev, i-1 {
+		if l.equal.equals(currentNode.value, value) {
+			l.unlink(currentNode, i)
+			return nil
+		}
 	}
-	return l.RemoveAt(index)
+	return errors.New("value not found")
+}
+
+// RemoveDuplicates removes duplicate nodes from the doubly linked list.
+// By default only adjacent duplicates are removed, which removes every duplicate from a sorted list.
+// Passing KeepFirst or KeepLast removes every duplicate, keeping the first or the last occurrence of each value.
+// Subscribers receive the removals as a single batch.
+func (l *DoublyLinkedList[T]) RemoveDuplicates(mode ...DuplicateMode) {
+	l.removeDuplicates(identity[T], duplicateMode(mode))
+}
+
+// DistinctBy removes nodes whose values have the same key, as returned by key, as another node.
+// The mode selects which duplicates are removed, as for RemoveDuplicates; the default is Adjacent.
+func (l *DoublyLinkedList[T]) DistinctBy(key func(value T) interface{}, mode ...DuplicateMode) {
+	l.removeDuplicates(key, duplicateMode(mode))
+}
+
+// removeDuplicates removes the nodes rejected by the duplicate filter for mode.
+func (l *DoublyLinkedList[T]) removeDuplicates(key func(value T) interface{}, mode DuplicateMode) {
+	keep := duplicateFilter(mode, func() []interface{} {
+		keys := make([]interface{}, 0, l.size)
+		for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+			keys = append(keys, key(currentNode.value))
+		}
+		return keys
+	})
+
+	l.observers.Batch(func() {
+		for currentNode, i := l.head, 0; currentNode != nil; {
+			next := currentNode.next
+			if !keep(key(currentNode.value)) {
+				l.unlink(currentNode, i)
+			} else {
+				i++
+			}
+			currentNode = next
+		}
+	})
 }
 
 // Contains checks if the doubly linked list contains the specified value.
 // It returns true if the value is found, otherwise it returns false.
-func (l *DoublyLinkedList) Contains(value interface{}) bool {
+func (l *DoublyLinkedList[T]) Contains(value T) bool {
 	return l.Search(value) != -1
 }
 
+// ContainsFunc checks if the doubly linked list contains a value for which match returns true.
+func (l *DoublyLinkedList[T]) ContainsFunc(match func(value T) bool) bool {
+	return l.IndexFunc(match) != -1
+}
+
 // Clear removes all elements from the doubly linked list.
 // It sets the head and tail pointers to nil and resets the size to 0.
-func (l *DoublyLinkedList) Clear() {
+func (l *DoublyLinkedList[T]) Clear() {
+	empty := l.size == 0
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	l.owner = nil
+	l.modCount++
+	if !empty {
+		l.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
+	}
 }
 
 // Get returns the value at the specified index in the doubly linked list.
 // If the index is out of range, it returns an error.
-func (l *DoublyLinkedList) Get(index int) (interface{}, error) {
+func (l *DoublyLinkedList[T]) Get(index int) (T, error) {
 	if index < 0 || index >= l.Size() {
-		return nil, errors.New("index out of range")
+		var zero T
+		return zero, errors.New("index out of range")
 	}
-	currentNode := l.head
-	for i := 0; i < index; i++ {
-		currentNode = currentNode.next
-	}
-	return currentNode.value, nil
+	return l.nodeAt(index).value, nil
 }
 
 // Set sets the value at the specified index in the doubly linked list.
 // If the index is out of range, it returns an error.
-func (l *DoublyLinkedList) Set(index int, value interface{}) error {
+func (l *DoublyLinkedList[T]) Set(index int, value T) error {
 	if index < 0 || index >= l.Size() {
 		return errors.New("index out of range")
 	}
-	currentNode := l.head
-	for i := 0; i < index; i++ {
-		currentNode = currentNode.next
+	node := l.nodeAt(index)
+	old := node.value
+	node.value = value
+	l.observers.Emit(collections.Event[T]{Kind: collections.Updated, Index: index, Value: value, Old: old})
+	return nil
+}
+
+// PopFront removes the head of the doubly linked list and returns its value.
+// If the list is empty, it returns an error.
+func (l *DoublyLinkedList[T]) PopFront() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	node := l.head
+	l.unlink(node, 0)
+	return node.value, nil
+}
+
+// PopBack removes the tail of the doubly linked list and returns its value.
+// If the list is empty, it returns an error.
+func (l *DoublyLinkedList[T]) PopBack() (T, error) {
+	if l.tail == nil {
+		var zero T
+		return zero, errors.New("list is empty")
+	}
+	node := l.tail
+	l.unlink(node, l.size-1)
+	return node.value, nil
+}
+
+// Reverse reverses the doubly linked list in place by swapping the next and prev pointers of each node.
+// Subscribers receive a batch of a Cleared event followed by an Added event for every value in the new order.
+func (l *DoublyLinkedList[T]) Reverse() {
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.prev {
+		currentNode.next, currentNode.prev = currentNode.prev, currentNode.next
+	}
+	l.head, l.tail = l.tail, l.head
+	l.modCount++
+	if l.size > 1 && l.observers.Active() {
+		l.observers.Batch(func() {
+			l.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
+			l.emitChain(collections.Added, l.head, l.size, func(i int) int { return i })
+		})
+	}
+}
+
+// emitChain delivers an event of kind for each of the count nodes starting at first as a single batch.
+// The i-th node is reported at index(i).
+func (l *DoublyLinkedList[T]) emitChain(kind collections.EventKind, first *DoublyLinkedListNode[T], count int, index func(i int) int) {
+	if !l.observers.Active() {
+		return
+	}
+	l.observers.Batch(func() {
+		for n, i := first, 0; i < count; n, i = n.next, i+1 {
+			l.emit(kind, index(i), n.value)
+		}
+	})
+}
+
+// detach removes the chain of count nodes from first to last from the doubly linked list.
+// The nodes keep their links to each other and their owner.
+func (l *DoublyLinkedList[T]) detach(first, last *DoublyLinkedListNode[T], count int) {
+	if first.prev == nil {
+		l.head = last.next
+	} else {
+		first.prev.next = last.next
+	}
+	if last.next == nil {
+		l.tail = first.prev
+	} else {
+		last.next.prev = first.prev
+	}
+	first.prev = nil
+	last.next = nil
+	l.size -= count
+	l.modCount++
+}
+
+// attach inserts the chain of count nodes from first to last between prev and next,
+// which must be adjacent nodes of the list. It does not change the owner of the nodes.
+func (l *DoublyLinkedList[T]) attach(first, last, prev, next *DoublyLinkedListNode[T], count int) {
+	first.prev = prev
+	last.next = next
+	if prev == nil {
+		l.head = first
+	} else {
+		prev.next = first
+	}
+	if next == nil {
+		l.tail = last
+	} else {
+		next.prev = last
+	}
+	l.size += count
+	l.modCount++
+}
+
+// retag makes the count nodes starting at first belong to the doubly linked list.
+func (l *DoublyLinkedList[T]) retag(first *DoublyLinkedListNode[T], count int) {
+	owner := l.id()
+	for n, i := first, 0; i < count; n, i = n.next, i+1 {
+		n.owner = owner
+	}
+}
+
+// absorb makes the nodes of other belong to the doubly linked list in constant time and leaves other empty.
+func (l *DoublyLinkedList[T]) absorb(other *DoublyLinkedList[T]) {
+	if other.owner != nil {
+		other.owner.forward = l.id()
+	}
+	other.head = nil
+	other.tail = nil
+	other.size = 0
+	other.owner = nil
+	other.modCount++
+}
+
+// Concat moves all nodes of other to the end of the doubly linked list in constant time.
+// The other list is left empty; its nodes now belong to this list.
+// Subscribers of this list receive the added values as a single batch, and subscribers of other a Cleared event.
+func (l *DoublyLinkedList[T]) Concat(other *DoublyLinkedList[T]) {
+	if other == l || other.head == nil {
+		return
+	}
+	first, count, size := other.head, other.size, l.size
+	l.attach(other.head, other.tail, l.tail, nil, other.size)
+	l.absorb(other)
+	l.emitChain(collections.Added, first, count, func(i int) int { return size + i })
+	other.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
+}
+
+// SplitAt splits the doubly linked list at the specified index. The nodes from index to the tail
+// are moved to a new list, which is returned, and the nodes before index remain in this one.
+// It takes time proportional to the smaller of the two parts.
+// If the index is out of range, it returns an error.
+func (l *DoublyLinkedList[T]) SplitAt(index int) (*DoublyLinkedList[T], error) {
+	if index < 0 || index > l.Size() {
+		return nil, errors.New("index out of range")
+	}
+
+	other := &DoublyLinkedList[T]{equal: l.equal}
+	if index == l.Size() {
+		return other, nil
+	}
+
+	first, last, count := l.nodeAt(index), l.tail, l.size-index
+	l.detach(first, last, count)
+	other.attach(first, last, nil, nil, count)
+	l.emitChain(collections.Removed, first, count, func(int) int { return index })
+
+	// Retag whichever part is smaller; the other part keeps the current owner.
+	if index < count {
+		other.owner, l.owner = l.owner, nil
+		if l.head != nil {
+			l.retag(l.head, index)
+		}
+	} else {
+		other.retag(first, count)
+	}
+	return other, nil
+}
+
+// SpliceRange moves the nodes from index from (inclusive) to index to (exclusive) into the
+// doubly linked list into, so that the first moved node ends up at index at of into.
+// When into is the list itself, at refers to a position before the move and must not
+// fall strictly inside the range.
+// It takes time proportional to the distance to the indexes plus the number of moved nodes.
+// Subscribers receive the removed and the added values as a batch of each, or a single batch when into is the list itself.
+// If any index is out of range, it returns an error.
+func (l *DoublyLinkedList[T]) SpliceRange(from, to int, into *DoublyLinkedList[T], at int) error {
+	if from < 0 || to > l.Size() || from > to || at < 0 || at > into.Size() {
+		return errors.New("index out of range")
+	}
+	if into == l {
+		if at > from && at < to {
+			return errors.New("index out of range")
+		}
+		if at >= to {
+			at -= to - from
+		}
+	}
+	if from == to {
+		return nil
+	}
+
+	count := to - from
+	first := l.nodeAt(from)
+	last := first
+	for i := 1; i < count; i++ {
+		last = last.next
+	}
+	l.detach(first, last, count)
+
+	if at == into.Size() {
+		into.attach(first, last, into.tail, nil, count)
+	} else {
+		next := into.nodeAt(at)
+		into.attach(first, last, next.prev, next, count)
+	}
+	if into != l {
+		into.retag(first, count)
+	}
+	l.observers.Batch(func() {
+		l.emitChain(collections.Removed, first, count, func(int) int { return from })
+		into.emitChain(collections.Added, first, count, func(i int) int { return at + i })
+	})
+	return nil
+}
+
+// MergeSorted merges the nodes of other into the doubly linked list, which must both be sorted
+// according to less, so that the result is sorted as well. The merge is stable: for equal values,
+// nodes of this list come first. It relinks the existing nodes and runs in linear time.
+// The other list is left empty; its nodes now belong to this list.
+// Subscribers of this list receive the values of other as a single batch of Added events at their new indexes,
+// and subscribers of other a Cleared event.
+func (l *DoublyLinkedList[T]) MergeSorted(other *DoublyLinkedList[T], less func(a, b T) bool) {
+	if other == l || other.head == nil {
+		return
+	}
+
+	var head, tail *DoublyLinkedListNode[T]
+	var added []collections.Event[T]
+	observed := l.observers.Active()
+	a, b := l.head, other.head
+	for i := 0; a != nil || b != nil; i++ {
+		var n *DoublyLinkedListNode[T]
+		if b == nil || (a != nil && !less(b.value, a.value)) {
+			n, a = a, a.next
+		} else {
+			n, b = b, b.next
+			if observed {
+				added = append(added, collections.Event[T]{Kind: collections.Added, Index: i, Value: n.value})
+			}
+		}
+		n.prev = tail
+		if tail == nil {
+			head = n
+		} else {
+			tail.next = n
+		}
+		tail = n
+	}
+
+	l.head = head
+	l.tail = tail
+	l.size += other.size
+	l.modCount++
+	l.absorb(other)
+	l.observers.Batch(func() {
+		for _, event := range added {
+			l.observers.Emit(event)
+		}
+	})
+	other.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
+}
+
+// Validate checks the internal consistency of the doubly linked list: the prev pointer of every node
+// must point to the node before it, every node must belong to the list, the number of nodes must
+// equal Size() and the tail must be the last node. It stops after Size()+1 nodes, so it terminates
+// even if the next pointers contain a cycle.
+// It returns an error describing the first problem found, or nil if the list is consistent.
+func (l *DoublyLinkedList[T]) Validate() error {
+	count := 0
+	var prev *DoublyLinkedListNode[T]
+	for n := l.head; n != nil; n = n.next {
+		if count == l.size {
+			return errors.New("size does not match the number of nodes")
+		}
+		if n.prev != prev {
+			return errors.New("prev pointer does not point to the previous node")
+		}
+		if !l.owns(n) {
+			return errors.New("node does not belong to the list")
+		}
+		prev = n
+		count++
+	}
+	if count != l.size {
+		return errors.New("size does not match the number of nodes")
+	}
+	if prev != l.tail {
+		return errors.New("tail is not the last node")
 	}
-	currentNode.value = value
 	return nil
 }
+
+// Subscribe registers fn to be called with an event for every change to the doubly linked list,
+// and returns a function that unregisters it. Events carry the index of the changed node.
+// Operations that take a node rather than an index, such as RemoveNode and InsertAfter,
+// take linear time to find the index while there are subscribers.
+func (l *DoublyLinkedList[T]) Subscribe(fn func(event collections.Event[T])) (unsubscribe func()) {
+	return l.observers.Subscribe(fn)
+}
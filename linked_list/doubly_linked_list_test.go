@@ -1,12 +1,16 @@
 package linked_list
 
 import (
+	"slices"
 	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
 )
 
 func TestDoublyLinkedListInsertAt(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test inserting at index 0 when the list is empty
 	err := list.InsertAt(0, 1)
@@ -90,7 +94,7 @@ func TestDoublyLinkedListInsertAt(t *testing.T) {
 
 func TestDoublyLinkedListInsertAtInvalidIndex(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test inserting at a negative index
 	err := list.InsertAt(-1, 1)
@@ -111,7 +115,7 @@ func TestDoublyLinkedListInsertAtInvalidIndex(t *testing.T) {
 
 func TestDoublyLinkedListRemoveAt(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test removing from an empty list
 	err := list.RemoveAt(0)
@@ -182,7 +186,7 @@ func TestDoublyLinkedListRemoveAt(t *testing.T) {
 
 func TestDoublyLinkedListRemoveAtInvalidIndex(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test removing at a negative index
 	err := list.RemoveAt(-1)
@@ -203,7 +207,7 @@ func TestDoublyLinkedListRemoveAtInvalidIndex(t *testing.T) {
 
 func TestDoublyLinkedListGet(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test getting from an empty list
 	_, err := list.Get(0)
@@ -235,7 +239,7 @@ func TestDoublyLinkedListGet(t *testing.T) {
 
 func TestDoublyLinkedListGetInvalidIndex(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test getting at a negative index
 	_, err := list.Get(-1)
@@ -256,7 +260,7 @@ func TestDoublyLinkedListGetInvalidIndex(t *testing.T) {
 
 func TestDoublyLinkedListSize(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test size of an empty list
 	if list.Size() != 0 {
@@ -279,7 +283,7 @@ func TestDoublyLinkedListSize(t *testing.T) {
 
 func TestDoublyLinkedListIsEmpty(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test IsEmpty on an empty list
 	if !list.IsEmpty() {
@@ -303,7 +307,7 @@ func TestDoublyLinkedListIsEmpty(t *testing.T) {
 func TestDoublyLinkedListClear(t *testing.T) {
 
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test clearing an empty list
 	list.Clear()
@@ -330,7 +334,7 @@ func TestDoublyLinkedListClear(t *testing.T) {
 
 func TestDoublyLinkedListContains(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Contains on an empty list
 	if list.Contains(1) {
@@ -359,7 +363,7 @@ func TestDoublyLinkedListContains(t *testing.T) {
 
 func TestDoublyLinkedListSearch(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Search on an empty list
 	if list.Search(1) != -1 {
@@ -388,7 +392,7 @@ func TestDoublyLinkedListSearch(t *testing.T) {
 
 func TestDoublyLinkedListValues(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Values on an empty list
 	values := list.Values()
@@ -426,7 +430,7 @@ func TestDoublyLinkedListValues(t *testing.T) {
 
 func TestDoublyLinkedListHead(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Head on an empty list
 	if list.Head() != nil {
@@ -449,7 +453,7 @@ func TestDoublyLinkedListHead(t *testing.T) {
 
 func TestDoublyLinkedListTail(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Tail on an empty list
 	if list.Tail() != nil {
@@ -473,7 +477,7 @@ func TestDoublyLinkedListTail(t *testing.T) {
 // add,remove,set
 func TestDoublyLinkedListAdd(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Add on an empty list
 	list.Add(1)
@@ -496,7 +500,7 @@ func TestDoublyLinkedListAdd(t *testing.T) {
 
 func TestDoublyLinkedListRemove(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test Remove on an empty list
 	list.Remove(1)
@@ -523,7 +527,7 @@ func TestDoublyLinkedListRemove(t *testing.T) {
 
 func TestDoublyLinkedListSet(t *testing.T) {
 	// Create a new instance of the DoublyLinkedList struct
-	list := NewDoublyLinkedList()
+	list := NewDoublyLinkedList[int]()
 
 	// Test setting a value at index 0 when the list is empty will result in an error
 	err := list.Set(0, 1)
@@ -584,3 +588,824 @@ func TestDoublyLinkedListSet(t *testing.T) {
 		t.Errorf("Expected list size to be 1, got %d", list.Size())
 	}
 }
+
+func TestDoublyLinkedListNodeOperations(t *testing.T) {
+	// Create a new instance of the DoublyLinkedList struct
+	list := NewDoublyLinkedList[int]()
+
+	// Test PushBack and PushFront
+	two := list.PushBack(2)
+	one := list.PushFront(1)
+	three := list.PushBack(3)
+	if list.Head() != one || list.Tail() != three || list.Size() != 3 {
+		t.Errorf("Expected list to be [1 2 3], got %v", list.Values())
+	}
+	if two.Prev() != one || two.Next() != three || two.Value() != 2 {
+		t.Errorf("Expected node 2 to be linked between 1 and 3")
+	}
+
+	// Test MoveToFront and MoveToBack
+	list.MoveToFront(three)
+	list.MoveToBack(one)
+	expected := []int{3, 2, 1}
+	for i, v := range list.Values() {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if list.Head() != three || list.Tail() != one {
+		t.Errorf("Expected head to be 3 and tail to be 1")
+	}
+
+	// Test InsertBefore and InsertAfter
+	if _, err := list.InsertBefore(two, 4); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if _, err := list.InsertAfter(one, 5); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expected = []int{3, 4, 2, 1, 5}
+	for i, v := range list.Values() {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if list.Tail().Value() != 5 {
+		t.Errorf("Expected tail to be 5, got %v", list.Tail().Value())
+	}
+
+	// Test RemoveNode
+	if err := list.RemoveNode(two); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if list.Size() != 4 || list.Contains(2) {
+		t.Errorf("Expected 2 to be removed, got %v", list.Values())
+	}
+
+	// Test operations with a node that no longer belongs to the list
+	if err := list.RemoveNode(two); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if err := list.MoveToFront(NewDoublyLinkedList[int]().PushBack(1)); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if _, err := list.InsertAfter(nil, 1); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListIndexFromEitherEnd(t *testing.T) {
+	// Create a new DoublyLinkedList with ten elements
+	list := NewDoublyLinkedList[int]()
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+
+	// Test Get and Set at every index, walking from both ends
+	for i := 0; i < 10; i++ {
+		if v, _ := list.Get(i); v != i {
+			t.Errorf("Expected element at index %d to be %d, got %v", i, i, v)
+		}
+		list.Set(i, i*10)
+	}
+
+	// Test InsertAt and RemoveAt near the tail
+	list.InsertAt(8, 75)
+	list.RemoveAt(10)
+	expected := []int{0, 10, 20, 30, 40, 50, 60, 70, 75, 80}
+	for i, v := range list.Values() {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if list.Tail().Value() != 80 || list.Size() != 10 {
+		t.Errorf("Expected tail to be 80, got %v", list.Tail().Value())
+	}
+}
+
+func TestDoublyLinkedListLastIndexOfAndRemoveLast(t *testing.T) {
+	// Create a new DoublyLinkedList with duplicates
+	list := NewDoublyLinkedList[int]()
+	for _, v := range []int{1, 2, 1, 3, 1} {
+		list.Add(v)
+	}
+
+	// Test LastIndexOf
+	if list.LastIndexOf(1) != 4 {
+		t.Errorf("Expected last index of 1 to be 4, got %d", list.LastIndexOf(1))
+	}
+	if list.LastIndexOf(4) != -1 {
+		t.Errorf("Expected last index of 4 to be -1, got %d", list.LastIndexOf(4))
+	}
+
+	// Test RemoveLast
+	if err := list.RemoveLast(1); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if list.LastIndexOf(1) != 2 || list.Search(1) != 0 {
+		t.Errorf("Expected [1 2 1 3], got %v", list.Values())
+	}
+	if err := list.RemoveLast(4); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListPopFrontPopBack(t *testing.T) {
+	// Create a new DoublyLinkedList
+	list := NewDoublyLinkedList[int]()
+	list.Add(1)
+	list.Add(2)
+	list.Add(3)
+
+	// Test PopFront and PopBack
+	if v, err := list.PopFront(); err != nil || v != 1 {
+		t.Errorf("Expected 1, got %v (%v)", v, err)
+	}
+	if v, err := list.PopBack(); err != nil || v != 3 {
+		t.Errorf("Expected 3, got %v (%v)", v, err)
+	}
+	if v, _ := list.PopBack(); v != 2 {
+		t.Errorf("Expected 2, got %v", v)
+	}
+	if list.Head() != nil || list.Tail() != nil {
+		t.Errorf("Expected head and tail to be nil")
+	}
+
+	// Test popping from an empty list
+	if _, err := list.PopFront(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if _, err := list.PopBack(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListReverse(t *testing.T) {
+	// Create a new DoublyLinkedList
+	list := NewDoublyLinkedList[int]()
+	for i := 1; i <= 4; i++ {
+		list.Add(i)
+	}
+
+	// Test Reverse
+	list.Reverse()
+	expected := []int{4, 3, 2, 1}
+	for i, v := range list.Values() {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if list.Head().Prev() != nil || list.Tail().Next() != nil || list.Tail().Prev().Value() != 2 {
+		t.Errorf("Expected prev and next pointers to be reversed")
+	}
+
+	// Test reversing an empty list
+	empty := NewDoublyLinkedList[int]()
+	empty.Reverse()
+	if !empty.IsEmpty() {
+		t.Errorf("Expected list to be empty")
+	}
+}
+
+// newDoublyLinkedListOf creates a doubly linked list containing the specified values.
+func newDoublyLinkedListOf[T comparable](values ...T) *DoublyLinkedList[T] {
+	list := NewDoublyLinkedList[T]()
+	for _, v := range values {
+		list.Add(v)
+	}
+	return list
+}
+
+// expectDoublyLinkedList checks that list contains exactly the expected values,
+// in both directions, and that every node belongs to it.
+func expectDoublyLinkedList[T comparable](t *testing.T, list *DoublyLinkedList[T], expected ...T) {
+	t.Helper()
+	if list.Size() != len(expected) {
+		t.Fatalf("Expected size to be %d, got %d", len(expected), list.Size())
+	}
+	i := 0
+	for n := list.Head(); n != nil; n = n.Next() {
+		if i >= len(expected) || n.Value() != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, list.Values())
+		}
+		if !list.owns(n) {
+			t.Errorf("Expected node %v to belong to the list", n.Value())
+		}
+		i++
+	}
+	for n := list.Tail(); n != nil; n = n.Prev() {
+		i--
+		if n.Value() != expected[i] {
+			t.Fatalf("Expected %v walking backward, got %v at index %d", expected, n.Value(), i)
+		}
+	}
+	if i != 0 {
+		t.Errorf("Expected to walk back to the head")
+	}
+}
+
+func TestDoublyLinkedListConcat(t *testing.T) {
+	// Create two doubly linked lists
+	list := newDoublyLinkedListOf(1, 2)
+	other := newDoublyLinkedListOf(3, 4)
+	node := other.Head()
+
+	// Test concatenating the lists
+	list.Concat(other)
+	expectDoublyLinkedList(t, list, 1, 2, 3, 4)
+	expectDoublyLinkedList(t, other)
+
+	// Test that the moved nodes now belong to the receiving list
+	if err := list.MoveToFront(node); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if _, err := other.InsertAfter(node, 5); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	expectDoublyLinkedList(t, list, 3, 1, 2, 4)
+
+	// Test concatenating a list that had absorbed another one
+	third := newDoublyLinkedListOf(5)
+	third.Concat(list)
+	if err := third.RemoveNode(node); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expectDoublyLinkedList(t, third, 5, 1, 2, 4)
+}
+
+func TestDoublyLinkedListSplitAt(t *testing.T) {
+	// Create a new doubly linked list
+	list := newDoublyLinkedListOf(1, 2, 3, 4, 5)
+	second, fourth := list.Head().Next(), list.Tail().Prev()
+
+	// Test splitting near the head and near the tail
+	other, err := list.SplitAt(1)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expectDoublyLinkedList(t, list, 1)
+	expectDoublyLinkedList(t, other, 2, 3, 4, 5)
+	last, _ := other.SplitAt(3)
+	expectDoublyLinkedList(t, other, 2, 3, 4)
+	expectDoublyLinkedList(t, last, 5)
+
+	// Test that ownership follows the nodes
+	if list.RemoveNode(second) == nil || other.RemoveNode(fourth) != nil {
+		t.Errorf("Expected nodes to belong to the list they were split into")
+	}
+
+	// Test splitting at both ends and out of range
+	empty, _ := other.SplitAt(2)
+	expectDoublyLinkedList(t, empty)
+	all, _ := other.SplitAt(0)
+	expectDoublyLinkedList(t, other)
+	expectDoublyLinkedList(t, all, 2, 3)
+	if _, err := other.SplitAt(1); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListSpliceRange(t *testing.T) {
+	// Create two doubly linked lists
+	list := newDoublyLinkedListOf(1, 2, 3, 4, 5)
+	other := newDoublyLinkedListOf(10, 20)
+
+	// Test moving a range into the middle of another list
+	if err := list.SpliceRange(1, 3, other, 1); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	expectDoublyLinkedList(t, list, 1, 4, 5)
+	expectDoublyLinkedList(t, other, 10, 2, 3, 20)
+
+	// Test moving the tail of a list to the end of another list
+	list.SpliceRange(1, 3, other, 4)
+	expectDoublyLinkedList(t, list, 1)
+	expectDoublyLinkedList(t, other, 10, 2, 3, 20, 4, 5)
+
+	// Test moving a range within the same list
+	other.SpliceRange(4, 6, other, 0)
+	expectDoublyLinkedList(t, other, 4, 5, 10, 2, 3, 20)
+	other.SpliceRange(0, 2, other, 6)
+	expectDoublyLinkedList(t, other, 10, 2, 3, 20, 4, 5)
+
+	// Test invalid ranges
+	if other.SpliceRange(0, 3, other, 1) == nil || list.SpliceRange(0, 2, other, 0) == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListMergeSorted(t *testing.T) {
+	// Create two sorted doubly linked lists
+	list := newDoublyLinkedListOf(1, 3, 5, 7)
+	other := newDoublyLinkedListOf(2, 3, 8, 9)
+	less := func(a, b int) bool { return a < b }
+	first3 := list.Head().Next()
+
+	// Test merging the lists
+	c := list.Front()
+	list.MergeSorted(other, less)
+	expectDoublyLinkedList(t, list, 1, 2, 3, 3, 5, 7, 8, 9)
+	expectDoublyLinkedList(t, other)
+
+	// Test that the merge is stable and invalidates cursors
+	if list.Head().Next().Next() != first3 {
+		t.Errorf("Expected the 3 from the receiving list to come first")
+	}
+	if c.Valid() {
+		t.Errorf("Expected cursor to be invalid")
+	}
+}
+
+func TestDoublyLinkedListValidate(t *testing.T) {
+	// Create a new doubly linked list
+	list := newDoublyLinkedListOf(1, 2, 3)
+	if err := list.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	// Test a broken prev pointer
+	list.Tail().prev = list.Head()
+	if err := list.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	list.Tail().prev = list.Head().Next()
+
+	// Test a cycle in the next pointers
+	list.Tail().next = list.Head()
+	if err := list.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if len(list.Values()) != 3 {
+		t.Errorf("Expected Values to stop after 3 nodes, got %v", list.Values())
+	}
+	list.Tail().next = nil
+
+	// Test a node of another list
+	other := newDoublyLinkedListOf(4)
+	list.Tail().next = other.Head()
+	other.Head().prev = list.Tail()
+	list.size++
+	list.tail = other.Head()
+	if err := list.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test a wrong size and tail
+	list = newDoublyLinkedListOf(1, 2, 3)
+	list.size = 4
+	if err := list.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	list.size = 3
+	list.tail = list.Head()
+	if err := list.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListRemoveDuplicates(t *testing.T) {
+	// Test each mode
+	list := newDoublyLinkedListOf(1, 1, 2, 1, 3, 3, 2)
+	list.RemoveDuplicates()
+	expectDoublyLinkedList(t, list, 1, 2, 1, 3, 2)
+
+	list = newDoublyLinkedListOf(1, 1, 2, 1, 3, 3, 2)
+	list.RemoveDuplicates(KeepFirst)
+	expectDoublyLinkedList(t, list, 1, 2, 3)
+
+	list = newDoublyLinkedListOf(1, 1, 2, 1, 3, 3, 2)
+	list.RemoveDuplicates(KeepLast)
+	expectDoublyLinkedList(t, list, 1, 3, 2)
+
+	// Test DistinctBy with a key that ignores the sign
+	list = newDoublyLinkedListOf(-1, 2, 1, -2, 3)
+	list.DistinctBy(func(value int) interface{} {
+		if value < 0 {
+			return -value
+		}
+		return value
+	}, KeepFirst)
+	expectDoublyLinkedList(t, list, -1, 2, 3)
+}
+
+func TestDoublyLinkedListWithEqual(t *testing.T) {
+	// Create a new doubly linked list that compares slices by length
+	list := NewDoublyLinkedListFunc(func(a, b []int) bool {
+		return len(a) == len(b)
+	})
+	list.Add([]int{1})
+	list.Add([]int{1, 2})
+	list.Add([]int{3})
+
+	// Test Search, LastIndexOf and Contains with values that == cannot compare
+	if list.Search([]int{9}) != 0 || list.LastIndexOf([]int{9}) != 2 || !list.Contains([]int{0, 0}) {
+		t.Errorf("Expected matches by length")
+	}
+
+	// Test Remove and RemoveLast
+	list.RemoveLast([]int{0})
+	list.Remove([]int{0, 0})
+	if list.Size() != 1 || list.Search([]int{7}) != 0 {
+		t.Errorf("Expected a single slice of length 1, got %v", list.Values())
+	}
+
+	// Test that split lists use the same equality function
+	list.Add([]int{4, 5})
+	other, _ := list.SplitAt(1)
+	if !other.Contains([]int{0, 0}) {
+		t.Errorf("Expected split list to match by length")
+	}
+}
+
+func TestDoublyLinkedListFuncs(t *testing.T) {
+	// Create a new doubly linked list
+	list := newDoublyLinkedListOf(1, 2, 3, 4, 5, 6)
+	even := func(value int) bool { return value%2 == 0 }
+
+	// Test ContainsFunc and IndexFunc
+	if !list.ContainsFunc(even) || list.IndexFunc(even) != 1 {
+		t.Errorf("Expected the first even value at index 1, got %d", list.IndexFunc(even))
+	}
+
+	// Test RemoveFunc and RemoveAllFunc
+	if err := list.RemoveFunc(even); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if removed := list.RemoveAllFunc(even); removed != 2 {
+		t.Errorf("Expected 2 values to be removed, got %d", removed)
+	}
+	expectDoublyLinkedList(t, list, 1, 3, 5)
+	if err := list.RemoveFunc(even); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestDoublyLinkedListTypedValues(t *testing.T) {
+	// Create a new doubly linked list of structs, compared by id
+	type user struct {
+		id   int
+		name string
+	}
+	list := NewDoublyLinkedList(WithEqual(func(a, b user) bool { return a.id == b.id }))
+	list.Add(user{1, "alice"})
+	list.Add(user{2, "bob"})
+
+	// Test that values and nodes are typed
+	if list.Head().Value().name != "alice" {
+		t.Errorf("Expected alice, got %v", list.Head().Value())
+	}
+	if v, _ := list.Get(1); v.name != "bob" {
+		t.Errorf("Expected bob, got %v", v)
+	}
+	if list.Search(user{2, ""}) != 1 {
+		t.Errorf("Expected user 2 at index 1")
+	}
+
+	// Test a zero-value list, which compares with ==
+	var zero DoublyLinkedList[string]
+	zero.Add("a")
+	if !zero.Contains("a") || zero.Contains("b") {
+		t.Errorf("Expected the zero-value list to compare with ==")
+	}
+}
+
+// subscribeReplay subscribes to list and returns a function that checks that replaying the events
+// delivered since reproduces the list, and the number of notifications.
+func subscribeReplay[T comparable](t *testing.T, list *DoublyLinkedList[T]) (check func(operation string), notifications *int) {
+	replayed := list.Values()
+	notifications = new(int)
+	list.Subscribe(func(event collections.Event[T]) {
+		replayed = testkit.Replay(replayed, event)
+		*notifications++
+	})
+	return func(operation string) {
+		t.Helper()
+		if !slices.Equal(replayed, list.Values()) {
+			t.Errorf("Expected events to reproduce %v after %s, got %v", list.Values(), operation, replayed)
+		}
+	}, notifications
+}
+
+func TestDoublyLinkedListSubscribe(t *testing.T) {
+	// Test that replaying the events of every change reproduces the list
+	list := newDoublyLinkedListOf(1, 2, 3)
+	check, notifications := subscribeReplay(t, list)
+
+	list.Add(4)
+	list.PushFront(0)
+	list.PushBack(5)
+	check("Add and Push")
+	list.InsertAt(2, 7)
+	list.RemoveAt(3)
+	list.Set(1, 8)
+	check("InsertAt, RemoveAt and Set")
+	node := list.nodeAt(3)
+	list.InsertBefore(node, 9)
+	list.InsertAfter(node, 10)
+	check("InsertBefore and InsertAfter")
+	list.MoveToFront(node)
+	list.MoveToBack(list.nodeAt(2))
+	check("MoveToFront and MoveToBack")
+	list.RemoveNode(node)
+	list.Remove(9)
+	list.RemoveLast(5)
+	list.PopFront()
+	list.PopBack()
+	check("removals")
+
+	// Test that cursor edits are reported at the cursor position
+	c := list.Front()
+	c.Next()
+	c.InsertBefore(11)
+	c.InsertAfter(12)
+	c.Set(13)
+	c.Remove()
+	check("cursor edits")
+
+	// Test that bulk operations are delivered as a single notification each
+	list.Add(13)
+	list.Add(13)
+	*notifications = 0
+	list.RemoveDuplicates(KeepFirst)
+	check("RemoveDuplicates")
+	list.RemoveAllFunc(func(v int) bool { return v%2 == 0 })
+	check("RemoveAllFunc")
+	list.Reverse()
+	check("Reverse")
+	if *notifications != 3 {
+		t.Errorf("Expected 3 notifications, got %d", *notifications)
+	}
+
+	other := newDoublyLinkedListOf(20, 21, 22)
+	checkOther, _ := subscribeReplay(t, other)
+	list.Concat(other)
+	check("Concat")
+	checkOther("Concat")
+	tail, err := list.SplitAt(2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	check("SplitAt")
+	if err := tail.SpliceRange(0, 2, list, 1); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	check("SpliceRange")
+	if err := list.SpliceRange(0, 2, list, 4); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	check("SpliceRange within the list")
+
+	sorted := newDoublyLinkedListOf(1, 3, 5)
+	checkSorted, sortedNotifications := subscribeReplay(t, sorted)
+	merged := newDoublyLinkedListOf(0, 3, 6)
+	checkMerged, _ := subscribeReplay(t, merged)
+	sorted.MergeSorted(merged, func(a, b int) bool { return a < b })
+	checkSorted("MergeSorted")
+	checkMerged("MergeSorted")
+	if *sortedNotifications != 1 {
+		t.Errorf("Expected 1 notification for MergeSorted, got %d", *sortedNotifications)
+	}
+
+	list.Clear()
+	check("Clear")
+}
+
+func TestDoublyLinkedListUnsubscribe(t *testing.T) {
+	// Test that no events are delivered after unsubscribing
+	list := NewDoublyLinkedList[int]()
+	notifications := 0
+	unsubscribe := list.Subscribe(func(collections.Event[int]) { notifications++ })
+	list.Add(1)
+	unsubscribe()
+	list.Add(2)
+	if notifications != 1 {
+		t.Errorf("Expected 1 notification, got %d", notifications)
+	}
+}
+
+func TestDoublyLinkedListConformance(t *testing.T) {
+	// Test that DoublyLinkedList behaves like every other List
+	testkit.TestList(t, func() collections.List[int] { return NewDoublyLinkedList[int]() },
+		func(i int) int { return i })
+
+	// Test that Contains and IndexOf use the equality function of the list
+	testkit.TestList(t, func() collections.List[int] {
+		return NewDoublyLinkedList(WithEqual(func(a, b int) bool { return a%100 == b%100 }))
+	}, func(i int) int { return i }, testkit.WithEquivalent(func(i int) int { return i + 100 }))
+}
+
+func FuzzDoublyLinkedList(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 1, 3, 2, 1, 3, 2})
+	f.Add([]byte{0, 5, 0, 6, 5, 0, 6, 0, 7, 1, 8, 0, 9, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a DoublyLinkedList and to a slice, and compare them, and the changes replayed
+		// from the events of the list, after every operation
+		l := NewDoublyLinkedList[int]()
+		var model, replayed []int
+		l.Subscribe(func(event collections.Event[int]) { replayed = testkit.Replay(replayed, event) })
+		for _, op := range testkit.DecodeOps(data, 10) {
+			index := op.Arg % (len(model) + 2)
+			inRange := index >= 0 && index < len(model)
+			switch op.Code {
+			case 0:
+				l.Add(op.Arg)
+				model = append(model, op.Arg)
+			case 1:
+				err := l.InsertAt(index, op.Arg)
+				if (err == nil) != (index >= 0 && index <= len(model)) {
+					t.Fatalf("Expected InsertAt(%d) on %v to fail: %v, got %v", index, model, err == nil, err)
+				}
+				if err == nil {
+					model = slices.Insert(model, index, op.Arg)
+				}
+			case 2:
+				if err := l.RemoveAt(index); (err == nil) != inRange {
+					t.Fatalf("Expected RemoveAt(%d) on %v to fail: %v, got %v", index, model, !inRange, err)
+				}
+				if inRange {
+					model = slices.Delete(model, index, index+1)
+				}
+			case 3:
+				i := slices.Index(model, op.Arg)
+				if err := l.Remove(op.Arg); (err == nil) != (i >= 0) {
+					t.Fatalf("Expected Remove(%d) on %v to fail: %v, got %v", op.Arg, model, i < 0, err)
+				}
+				if i >= 0 {
+					model = slices.Delete(model, i, i+1)
+				}
+			case 4:
+				if got := l.Search(op.Arg); got != slices.Index(model, op.Arg) {
+					t.Fatalf("Expected Search(%d) on %v to be %d, got %d", op.Arg, model, slices.Index(model, op.Arg), got)
+				}
+			case 5:
+				v, err := l.Get(index)
+				if (err == nil) != inRange || inRange && v != model[index] {
+					t.Fatalf("Expected Get(%d) on %v to match, got %v (%v)", index, model, v, err)
+				}
+			case 6:
+				if err := l.Set(index, op.Arg); (err == nil) != inRange {
+					t.Fatalf("Expected Set(%d) on %v to fail: %v, got %v", index, model, !inRange, err)
+				}
+				if inRange {
+					model[index] = op.Arg
+				}
+			case 7:
+				v, err := l.PopFront()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[0] {
+					t.Fatalf("Expected PopFront on %v to match, got %v (%v)", model, v, err)
+				}
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			case 8:
+				v, err := l.PopBack()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[len(model)-1] {
+					t.Fatalf("Expected PopBack on %v to match, got %v (%v)", model, v, err)
+				}
+				if len(model) > 0 {
+					model = model[:len(model)-1]
+				}
+			case 9:
+				l.Reverse()
+				slices.Reverse(model)
+			}
+			if !slices.Equal(l.Values(), model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, l.Values())
+			}
+			if err := l.Validate(); err != nil {
+				t.Fatalf("Expected a valid list after %+v, got %v", op, err)
+			}
+			if !slices.Equal(replayed, model) {
+				t.Fatalf("Expected events to reproduce %v after %+v, got %v", model, op, replayed)
+			}
+		}
+	})
+}
+
+// newBenchDoublyLinkedList returns a doubly linked list holding the integers from 0 to n-1.
+func newBenchDoublyLinkedList(n int) *DoublyLinkedList[int] {
+	l := NewDoublyLinkedList[int]()
+	for i := 0; i < n; i++ {
+		l.Add(i)
+	}
+	return l
+}
+
+func BenchmarkDoublyLinkedListPushBackPopFront(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushBack(i)
+			benchSink, _ = l.PopFront()
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushBack(i)
+			benchSink = l.Remove(l.Front())
+		}
+	})
+}
+
+func BenchmarkDoublyLinkedListPushFrontPopBack(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushFront(i)
+			benchSink, _ = l.PopBack()
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushFront(i)
+			benchSink = l.Remove(l.Back())
+		}
+	})
+}
+
+func BenchmarkDoublyLinkedListGet(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink, _ = l.Get(i % n)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			e := l.Front()
+			for j := 0; j < i%n; j++ {
+				e = e.Next()
+			}
+			benchSink = e
+		}
+	})
+}
+
+func BenchmarkDoublyLinkedListInsertRemoveMiddle(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.InsertAt(n/2, i)
+			l.RemoveAt(n / 2)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			e := l.Front()
+			for j := 0; j < n/2; j++ {
+				e = e.Next()
+			}
+			l.Remove(l.InsertBefore(i, e))
+		}
+	})
+}
+
+func BenchmarkDoublyLinkedListContains(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = l.Contains(n / 2)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			found := false
+			for e := l.Front(); e != nil && !found; e = e.Next() {
+				found = e.Value.(int) == n/2
+			}
+			benchSink = found
+		}
+	})
+}
+
+func BenchmarkDoublyLinkedListReverse(b *testing.B) {
+	testkit.Bench(b, "DoublyLinkedList", func(b *testing.B, n int) {
+		l := newBenchDoublyLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.Reverse()
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			slices.Reverse(s)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package linked_list
+
+// DuplicateMode selects which nodes RemoveDuplicates and DistinctBy remove.
+type DuplicateMode int
+
+const (
+	// Adjacent removes a node only if its value equals the value of the node right before it,
+	// which removes every duplicate from a sorted list. It needs no extra memory.
+	Adjacent DuplicateMode = iota
+	// KeepFirst removes every node whose value occurs earlier in the list.
+	// It uses a hash set, so the values must be comparable.
+	KeepFirst
+	// KeepLast removes every node whose value occurs later in the list.
+	// It uses a hash map, so the values must be comparable.
+	KeepLast
+)
+
+// identity returns its argument; it is the key function of RemoveDuplicates.
+func identity[T any](value T) interface{} {
+	return value
+}
+
+// duplicateMode returns the mode passed to a variadic mode parameter, or Adjacent if there is none.
+func duplicateMode(mode []DuplicateMode) DuplicateMode {
+	if len(mode) > 0 {
+		return mode[0]
+	}
+	return Adjacent
+}
+
+// duplicateFilter returns a function that is called with the key of every element, in order,
+// and reports whether the element should be kept. keys returns the keys of all elements;
+// it is only called for KeepLast, which needs to know the later occurrences upfront.
+func duplicateFilter(mode DuplicateMode, keys func() []interface{}) func(key interface{}) bool {
+	switch mode {
+	case KeepFirst:
+		seen := make(map[interface{}]struct{})
+		return func(key interface{}) bool {
+			if _, ok := seen[key]; ok {
+				return false
+			}
+			seen[key] = struct{}{}
+			return true
+		}
+	case KeepLast:
+		remaining := make(map[interface{}]int)
+		for _, key := range keys() {
+			remaining[key]++
+		}
+		return func(key interface{}) bool {
+			remaining[key]--
+			return remaining[key] == 0
+		}
+	default:
+		first := true
+		var last interface{}
+		return func(key interface{}) bool {
+			keep := first || key != last
+			first = false
+			last = key
+			return keep
+		}
+	}
+}
@@ -1,38 +1,64 @@
 package linked_list
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"goCollections/collections"
+)
 
 // Node represents a node in a linked list.
-type Node struct {
-	value interface{} // The value stored in the node.
-	next  *Node       // Pointer to the next node in the list.
+type Node[T any] struct {
+	value T        // The value stored in the node.
+	next  *Node[T] // Pointer to the next node in the list.
+}
+
+// Value returns the value stored in the node.
+func (n *Node[T]) Value() T {
+	return n.value
 }
 
-// LinkedList represents a linked list data structure.
-type LinkedList struct {
-	head *Node // Pointer to the first node in the linked list
-	size int   // Number of nodes in the linked list
+// Next returns the next node in the list, or nil if the node is the last one.
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
 }
 
-// NewLinkedList creates and returns a new instance of LinkedList.
-func NewLinkedList() *LinkedList {
-	return &LinkedList{nil, 0}
+// LinkedList represents a linked list data structure holding values of type T.
+type LinkedList[T any] struct {
+	head  *Node[T]     // Pointer to the first node in the linked list
+	tail  *Node[T]     // Pointer to the last node in the linked list
+	size  int          // Number of nodes in the linked list
+	equal EqualFunc[T] // Compares values; nil means == on the dynamic values
+}
+
+// LinkedList is not a collections.List itself, because its RemoveAt returns the removed node instead of an error.
+// AsList returns a view of it that is.
+var _ collections.Collection[int] = (*LinkedList[int])(nil)
+
+// NewLinkedList creates and returns a new instance of LinkedList for comparable values.
+// Values are compared with == unless WithEqual is passed.
+func NewLinkedList[T comparable](opts ...Option[T]) *LinkedList[T] {
+	return &LinkedList[T]{nil, nil, 0, newConfig(opts, equalComparable[T]).equal}
+}
+
+// NewLinkedListFunc creates and returns a new instance of LinkedList for values of any type,
+// such as slices or structs with slice fields, which are compared with equal.
+func NewLinkedListFunc[T any](equal EqualFunc[T]) *LinkedList[T] {
+	return &LinkedList[T]{nil, nil, 0, equal}
 }
 
 // Add adds a new node with the specified value to the linked list.
 // If the linked list is empty, the new node becomes the head of the list.
 // Otherwise, the new node is inserted at the last of the list.
 // The size of the linked list is incremented after adding the new node.
-func (l *LinkedList) Add(value interface{}) bool {
+func (l *LinkedList[T]) Add(value T) bool {
+	n := &Node[T]{value, nil}
 	if l.head == nil {
-		l.head = &Node{value, nil}
+		l.head = n
 	} else {
-		n := l.head
-		for n.next != nil {
-			n = n.next
-		}
-		n.next = &Node{value, nil}
+		l.tail.next = n
 	}
+	l.tail = n
 	l.size++
 	return true
 }
@@ -40,20 +66,34 @@ func (l *LinkedList) Add(value interface{}) bool {
 // Remove removes the first occurrence of the specified value from the linked list.
 // If the value is found, it is removed and the size of the linked list is decremented.
 // If the value is not found or the linked list is empty, no changes are made.
-func (l *LinkedList) Remove(value interface{}) bool {
+func (l *LinkedList[T]) Remove(value T) bool {
+	return l.RemoveFunc(func(v T) bool {
+		return l.equal.equals(v, value)
+	})
+}
+
+// RemoveFunc removes the first node for whose value match returns true.
+// It returns true if a node was removed, otherwise false.
+func (l *LinkedList[T]) RemoveFunc(match func(value T) bool) bool {
 	if l.head == nil {
 		return false
 	}
 
-	if l.head.value == value {
+	if match(l.head.value) {
 		l.head = l.head.next
+		if l.head == nil {
+			l.tail = nil
+		}
 		l.size--
 		return true
 	}
 
 	prev := l.head
 	for prev.next != nil {
-		if prev.next.value == value {
+		if match(prev.next.value) {
+			if prev.next == l.tail {
+				l.tail = prev
+			}
 			prev.next = prev.next.next
 			l.size--
 			return true
@@ -68,73 +108,95 @@ func (l *LinkedList) Remove(value interface{}) bool {
 // comparing each node's value with the given value.
 // If a node with the same value is found, it returns true.
 // If the end of the linked list is reached without finding a matching value, it returns false.
-func (l *LinkedList) Contains(value interface{}) bool {
+func (l *LinkedList[T]) Contains(value T) bool {
+	return l.IndexOf(value) != -1
+}
+
+// ContainsFunc checks if the linked list contains a value for which match returns true.
+func (l *LinkedList[T]) ContainsFunc(match func(value T) bool) bool {
+	return l.IndexFunc(match) != -1
+}
+
+// RemoveAllFunc removes every node for whose value match returns true.
+// It returns the number of removed nodes.
+func (l *LinkedList[T]) RemoveAllFunc(match func(value T) bool) int {
+	removed := 0
+	var prev *Node[T]
 	for n := l.head; n != nil; n = n.next {
-		if n.value == value {
-			return true
+		if !match(n.value) {
+			prev = n
+			continue
 		}
+		if prev == nil {
+			l.head = n.next
+		} else {
+			prev.next = n.next
+		}
+		removed++
 	}
-	return false
+	l.tail = prev
+	l.size -= removed
+	return removed
 }
 
 // Size returns the number of nodes in the linked list.
-func (l *LinkedList) Size() int {
+func (l *LinkedList[T]) Size() int {
+	return l.size
+}
+
+// Len returns the number of nodes in the linked list. It is the same as Size.
+func (l *LinkedList[T]) Len() int {
 	return l.size
 }
 
 // IsEmpty returns true if the linked list is empty, false otherwise.
-func (l *LinkedList) IsEmpty() bool {
+func (l *LinkedList[T]) IsEmpty() bool {
 	return l.size == 0
 }
 
 // Clear removes all nodes from the linked list.
-func (l *LinkedList) Clear() {
+func (l *LinkedList[T]) Clear() {
 	l.head = nil
+	l.tail = nil
 	l.size = 0
 }
 
 // Values returns a slice containing the values in the linked list.
-func (l *LinkedList) Values() []interface{} {
-	values := make([]interface{}, l.size)
-	i := 0
-	for n := l.head; n != nil; n = n.next {
-		values[i] = n.value
-		i++
+// It stops after Size() nodes, so it terminates even if the list contains a cycle.
+func (l *LinkedList[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for n := l.head; n != nil && len(values) < l.size; n = n.next {
+		values = append(values, n.value)
 	}
 	return values
 }
 
 // String returns a string representation of the linked list.
-func (l *LinkedList) String() string {
+// It stops after Size() nodes, so it terminates even if the list contains a cycle.
+func (l *LinkedList[T]) String() string {
 	str := "["
-	for n := l.head; n != nil; n = n.next {
+	i := 0
+	for n := l.head; n != nil && i < l.size; n = n.next {
 		str += fmt.Sprintf(" %v", n.value)
+		i++
 	}
 	str += " ]"
 	return str
 }
 
 // GetHead returns the head node of the linked list.
-func (l *LinkedList) GetHead() *Node {
+func (l *LinkedList[T]) GetHead() *Node[T] {
 	return l.head
 }
 
 // GetTail returns the tail node of the linked list.
-func (l *LinkedList) GetTail() *Node {
-	if l.head == nil {
-		return nil
-	}
-
-	n := l.head
-	for n.next != nil {
-		n = n.next
-	}
-	return n
+func (l *LinkedList[T]) GetTail() *Node[T] {
+	return l.tail
 }
 
 // GetNode returns the node at the specified index.
 // If the index is out of bounds, it returns nil.
-func (l *LinkedList) GetNode(index int) *Node {
+func (l *LinkedList[T]) GetNode(index int) *Node[T] {
 	if index < 0 || index >= l.size {
 		return nil
 	}
@@ -149,13 +211,17 @@ func (l *LinkedList) GetNode(index int) *Node {
 // Insert inserts a new node with the specified value at the specified index.
 // If the index is out of bounds, it returns false.
 // Otherwise, it returns true.
-func (l *LinkedList) Insert(index int, value interface{}) bool {
+func (l *LinkedList[T]) Insert(index int, value T) bool {
 	if index < 0 || index > l.size {
 		return false
 	}
 
 	if index == 0 {
-		l.Add(value)
+		l.head = &Node[T]{value, l.head}
+		if l.tail == nil {
+			l.tail = l.head
+		}
+		l.size++
 		return true
 	}
 
@@ -163,7 +229,10 @@ func (l *LinkedList) Insert(index int, value interface{}) bool {
 	for i := 0; i < index-1; i++ {
 		n = n.next
 	}
-	n.next = &Node{value, n.next}
+	n.next = &Node[T]{value, n.next}
+	if n == l.tail {
+		l.tail = n.next
+	}
 	l.size++
 	return true
 }
@@ -171,7 +240,7 @@ func (l *LinkedList) Insert(index int, value interface{}) bool {
 // RemoveAt removes the node at the specified index.
 // If the index is out of bounds, it returns nil.
 // Otherwise, it returns the removed node.
-func (l *LinkedList) RemoveAt(index int) *Node {
+func (l *LinkedList[T]) RemoveAt(index int) *Node[T] {
 	if index < 0 || index >= l.size {
 		return nil
 	}
@@ -179,6 +248,9 @@ func (l *LinkedList) RemoveAt(index int) *Node {
 	if index == 0 {
 		n := l.head
 		l.head = l.head.next
+		if l.head == nil {
+			l.tail = nil
+		}
 		l.size--
 		return n
 	}
@@ -189,14 +261,71 @@ func (l *LinkedList) RemoveAt(index int) *Node {
 	}
 	removed := n.next
 	n.next = n.next.next
+	if removed == l.tail {
+		l.tail = n
+	}
 	l.size--
 	return removed
 }
 
+// Get returns the value at the specified index.
+// It returns an error if the index is out of range.
+func (l *LinkedList[T]) Get(index int) (T, error) {
+	n := l.GetNode(index)
+	if n == nil {
+		var zero T
+		return zero, errors.New("index out of range")
+	}
+	return n.value, nil
+}
+
+// Set replaces the value at the specified index.
+// It returns an error if the index is out of range.
+func (l *LinkedList[T]) Set(index int, value T) error {
+	n := l.GetNode(index)
+	if n == nil {
+		return errors.New("index out of range")
+	}
+	n.value = value
+	return nil
+}
+
+// InsertAt inserts a new node with the specified value at the specified index.
+// It is the same as Insert, but returns an error if the index is out of range.
+func (l *LinkedList[T]) InsertAt(index int, value T) error {
+	if !l.Insert(index, value) {
+		return errors.New("index out of range")
+	}
+	return nil
+}
+
+// listView is a LinkedList whose RemoveAt returns an error, so that it implements collections.List.
+type listView[T any] struct {
+	*LinkedList[T]
+}
+
+var _ collections.List[int] = listView[int]{}
+
+// AsList returns a view of the linked list that implements collections.List, so that it can be passed
+// to code that accepts any list, such as history.NewHistory. The view shares the nodes of the list,
+// and has every method of the list, except that RemoveAt returns an error instead of the removed node.
+func (l *LinkedList[T]) AsList() collections.List[T] {
+	return listView[T]{l}
+}
+
+// RemoveAt removes the node at the specified index.
+// It returns an error if the index is out of range.
+func (v listView[T]) RemoveAt(index int) error {
+	if v.LinkedList.RemoveAt(index) == nil {
+		return errors.New("index out of range")
+	}
+	return nil
+}
+
 // Equals compares the linked list with another linked list.
 // It returns true if both linked lists have the same size and contain the same values in the same order.
 // Otherwise, it returns false.
-func (l *LinkedList) Equals(other *LinkedList) bool {
+func (l *LinkedList[T]) Equals(other *LinkedList[T]) bool {
 	if l.size != other.size {
 		return false
 	}
@@ -204,7 +333,7 @@ func (l *LinkedList) Equals(other *LinkedList) bool {
 	n1 := l.head
 	n2 := other.head
 	for n1 != nil {
-		if n1.value != n2.value {
+		if !l.equal.equals(n1.value, n2.value) {
 			return false
 		}
 		n1 = n1.next
@@ -216,8 +345,8 @@ func (l *LinkedList) Equals(other *LinkedList) bool {
 // Copy returns a copy of the linked list.
 // It creates a new linked list and copies the values from the original linked list to the new linked list.
 // Returns a pointer to the new linked list.
-func (l *LinkedList) Copy() *LinkedList {
-	copy := NewLinkedList()
+func (l *LinkedList[T]) Copy() *LinkedList[T] {
+	copy := &LinkedList[T]{equal: l.equal}
 	for n := l.head; n != nil; n = n.next {
 		copy.Add(n.value)
 	}
@@ -226,9 +355,10 @@ func (l *LinkedList) Copy() *LinkedList {
 
 // Reverse reverses the linked list.
 // It iterates through the linked list and swaps the next pointers of each node.
-func (l *LinkedList) Reverse() {
-	var prev *Node
+func (l *LinkedList[T]) Reverse() {
+	var prev *Node[T]
 	current := l.head
+	l.tail = l.head
 	for current != nil {
 		next := current.next
 		current.next = prev
@@ -241,7 +371,7 @@ func (l *LinkedList) Reverse() {
 // GetMiddle returns the middle node of the linked list.
 // If the linked list has an even number of nodes, it returns the first middle node.
 // If the linked list is empty, it returns nil.
-func (l *LinkedList) GetMiddle() *Node {
+func (l *LinkedList[T]) GetMiddle() *Node[T] {
 	if l.head == nil {
 		return nil
 	}
@@ -257,7 +387,7 @@ func (l *LinkedList) GetMiddle() *Node {
 
 // GetNthFromEnd returns the nth node from the end of the linked list.
 // If the index is out of bounds, it returns nil.
-func (l *LinkedList) GetNthFromEnd(index int) *Node {
+func (l *LinkedList[T]) GetNthFromEnd(index int) *Node[T] {
 	if index < 0 || index >= l.size {
 		return nil
 	}
@@ -270,30 +400,58 @@ func (l *LinkedList) GetNthFromEnd(index int) *Node {
 }
 
 // RemoveDuplicates removes duplicate nodes from the linked list.
-// It iterates through the linked list and removes nodes with duplicate values.
-// If the linked list is empty, it returns nil.
-func (l *LinkedList) RemoveDuplicates() {
-	if l.head == nil {
-		return
-	}
+// By default only adjacent duplicates are removed, which removes every duplicate from a sorted list.
+// Passing KeepFirst or KeepLast removes every duplicate, keeping the first or the last occurrence of each value.
+func (l *LinkedList[T]) RemoveDuplicates(mode ...DuplicateMode) {
+	l.removeDuplicates(identity[T], duplicateMode(mode))
+}
 
-	n := l.head
-	for n != nil && n.next != nil {
-		if n.value == n.next.value {
-			n.next = n.next.next
-			l.size--
+// DistinctBy removes nodes whose values have the same key, as returned by key, as another node.
+// The mode selects which duplicates are removed, as for RemoveDuplicates; the default is Adjacent.
+func (l *LinkedList[T]) DistinctBy(key func(value T) interface{}, mode ...DuplicateMode) {
+	l.removeDuplicates(key, duplicateMode(mode))
+}
+
+// removeDuplicates removes the nodes rejected by the duplicate filter for mode.
+func (l *LinkedList[T]) removeDuplicates(key func(value T) interface{}, mode DuplicateMode) {
+	keep := duplicateFilter(mode, func() []interface{} {
+		keys := make([]interface{}, 0, l.size)
+		for n := l.head; n != nil; n = n.next {
+			keys = append(keys, key(n.value))
+		}
+		return keys
+	})
+
+	var prev *Node[T]
+	for n := l.head; n != nil; n = n.next {
+		if keep(key(n.value)) {
+			prev = n
+			continue
+		}
+		if prev == nil {
+			l.head = n.next
 		} else {
-			n = n.next
+			prev.next = n.next
 		}
+		l.size--
 	}
+	l.tail = prev
 }
 
 // IndexOf returns the index of the first occurrence of the specified value in the linked list.
 // If the value is found, the index is returned. If the value is not found, -1 is returned.
-func (l *LinkedList) IndexOf(value interface{}) int {
+func (l *LinkedList[T]) IndexOf(value T) int {
+	return l.IndexFunc(func(v T) bool {
+		return l.equal.equals(v, value)
+	})
+}
+
+// IndexFunc returns the index of the first value in the linked list for which match returns true.
+// If there is no such value, -1 is returned.
+func (l *LinkedList[T]) IndexFunc(match func(value T) bool) int {
 	index := 0
 	for n := l.head; n != nil; n = n.next {
-		if n.value == value {
+		if match(n.value) {
 			return index
 		}
 		index++
@@ -303,14 +461,236 @@ func (l *LinkedList) IndexOf(value interface{}) int {
 
 // LastIndexOf returns the index of the last occurrence of the specified value in the linked list.
 // If the value is found, the index is returned. If the value is not found, -1 is returned.
-func (l *LinkedList) LastIndexOf(value interface{}) int {
+func (l *LinkedList[T]) LastIndexOf(value T) int {
 	index := -1
 	i := 0
 	for n := l.head; n != nil; n = n.next {
-		if n.value == value {
+		if l.equal.equals(n.value, value) {
 			index = i
 		}
 		i++
 	}
 	return index
 }
+
+// Concat moves all nodes of other to the end of the linked list in constant time.
+// The other linked list is left empty.
+func (l *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == l || other.head == nil {
+		return
+	}
+	if l.head == nil {
+		l.head = other.head
+	} else {
+		l.tail.next = other.head
+	}
+	l.tail = other.tail
+	l.size += other.size
+	other.Clear()
+}
+
+// SplitAt splits the linked list at the specified index. The nodes from index to the end
+// are moved to a new linked list, which is returned, and the nodes before index remain in this one.
+// If the index is out of bounds, it returns nil.
+func (l *LinkedList[T]) SplitAt(index int) *LinkedList[T] {
+	if index < 0 || index > l.size {
+		return nil
+	}
+
+	other := &LinkedList[T]{equal: l.equal}
+	if index == l.size {
+		return other
+	}
+	if index == 0 {
+		*other = *l
+		l.Clear()
+		return other
+	}
+
+	last := l.GetNode(index - 1)
+	other.head = last.next
+	other.tail = l.tail
+	other.size = l.size - index
+	last.next = nil
+	l.tail = last
+	l.size = index
+	return other
+}
+
+// SpliceRange moves the nodes from index from (inclusive) to index to (exclusive) into the
+// linked list into, so that the first moved node ends up at index at of into.
+// When into is the linked list itself, at refers to a position before the move and must not
+// fall strictly inside the range.
+// If any index is out of bounds, it returns false.
+// Otherwise, it returns true.
+func (l *LinkedList[T]) SpliceRange(from, to int, into *LinkedList[T], at int) bool {
+	if from < 0 || to > l.size || from > to || at < 0 || at > into.size {
+		return false
+	}
+	if into == l {
+		if at > from && at < to {
+			return false
+		}
+		if at >= to {
+			at -= to - from
+		}
+	}
+	if from == to {
+		return true
+	}
+
+	// Detach the range, remembering the node before it.
+	var prev *Node[T]
+	if from > 0 {
+		prev = l.GetNode(from - 1)
+	}
+	first := l.head
+	if prev != nil {
+		first = prev.next
+	}
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.next
+	}
+	if prev == nil {
+		l.head = last.next
+	} else {
+		prev.next = last.next
+	}
+	if last == l.tail {
+		l.tail = prev
+	}
+	l.size -= to - from
+
+	// Attach the range to into.
+	if at == 0 {
+		last.next = into.head
+		into.head = first
+	} else {
+		n := into.GetNode(at - 1)
+		last.next = n.next
+		n.next = first
+	}
+	if last.next == nil {
+		into.tail = last
+	}
+	into.size += to - from
+	return true
+}
+
+// MergeSorted merges the nodes of other into the linked list, which must both be sorted
+// according to less, so that the result is sorted as well. The merge is stable: for equal values,
+// nodes of this linked list come first. It relinks the existing nodes and runs in linear time.
+// The other linked list is left empty.
+func (l *LinkedList[T]) MergeSorted(other *LinkedList[T], less func(a, b T) bool) {
+	if other == l || other.head == nil {
+		return
+	}
+
+	var head, tail *Node[T]
+	a, b := l.head, other.head
+	for a != nil || b != nil {
+		var n *Node[T]
+		if b == nil || (a != nil && !less(b.value, a.value)) {
+			n, a = a, a.next
+		} else {
+			n, b = b, b.next
+		}
+		if tail == nil {
+			head = n
+		} else {
+			tail.next = n
+		}
+		tail = n
+	}
+
+	l.head = head
+	l.tail = tail
+	l.size += other.size
+	other.Clear()
+}
+
+// HasCycle checks if following the next pointers from the head ever leads back to an earlier node.
+// It uses Floyd's tortoise and hare algorithm, which runs in linear time and constant space.
+func (l *LinkedList[T]) HasCycle() bool {
+	return l.meetingPoint() != nil
+}
+
+// meetingPoint returns the node where the slow and the fast pointer of Floyd's algorithm meet,
+// or nil if the list does not contain a cycle.
+func (l *LinkedList[T]) meetingPoint() *Node[T] {
+	slow := l.head
+	fast := l.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			return slow
+		}
+	}
+	return nil
+}
+
+// CycleStart returns the first node of the cycle, that is the node the last node of the cycle links back to.
+// If the linked list does not contain a cycle, it returns nil.
+func (l *LinkedList[T]) CycleStart() *Node[T] {
+	meeting := l.meetingPoint()
+	if meeting == nil {
+		return nil
+	}
+
+	// The distance from the head to the start of the cycle equals the distance
+	// from the meeting point to the start of the cycle, going around the cycle.
+	n := l.head
+	for n != meeting {
+		n = n.next
+		meeting = meeting.next
+	}
+	return n
+}
+
+// BreakCycle removes the link from the last node of the cycle back to its start, and recomputes
+// the size and tail of the linked list from the remaining nodes.
+// It returns true if a cycle was found and broken, false otherwise.
+func (l *LinkedList[T]) BreakCycle() bool {
+	start := l.CycleStart()
+	if start == nil {
+		return false
+	}
+
+	last := start
+	for last.next != start {
+		last = last.next
+	}
+	last.next = nil
+
+	l.size = 0
+	for n := l.head; n != nil; n = n.next {
+		l.size++
+	}
+	l.tail = last
+	return true
+}
+
+// Validate checks the internal consistency of the linked list: it must not contain a cycle,
+// the number of nodes must equal Size() and the tail must be the last node.
+// It returns an error describing the first problem found, or nil if the list is consistent.
+func (l *LinkedList[T]) Validate() error {
+	if l.HasCycle() {
+		return errors.New("list contains a cycle")
+	}
+
+	count := 0
+	var last *Node[T]
+	for n := l.head; n != nil; n = n.next {
+		last = n
+		count++
+	}
+	if count != l.size {
+		return errors.New("size does not match the number of nodes")
+	}
+	if last != l.tail {
+		return errors.New("tail is not the last node")
+	}
+	return nil
+}
@@ -1,12 +1,18 @@
 package linked_list
 
 import (
+	"container/list"
+	"slices"
+	"strings"
 	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
 )
 
 func TestLinkedList_RemoveAt(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -24,7 +30,7 @@ func TestLinkedList_RemoveAt(t *testing.T) {
 
 	// Test removing an element at index 2
 	removed = ll.RemoveAt(2)
-	if removed.value != nil && removed.value != 40 {
+	if removed != nil && removed.value != 40 {
 		t.Errorf("Expected removed element to be 30, got %v", removed.value)
 	}
 
@@ -41,7 +47,7 @@ func TestLinkedList_RemoveAt(t *testing.T) {
 	}
 
 	// Test removing an element from an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	removed = emptyList.RemoveAt(0)
 	if removed != nil {
 		t.Errorf("Expected removed element to be nil, got %v", removed)
@@ -50,7 +56,7 @@ func TestLinkedList_RemoveAt(t *testing.T) {
 
 func TestLinkedList_Insert(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -89,8 +95,11 @@ func TestLinkedList_Insert(t *testing.T) {
 		t.Errorf("Expected inserted to be false, got %v", inserted)
 	}
 
+	// Test that the elements were inserted at the right positions
+	expectLinkedList(t, ll, 5, 10, 25, 20, 30, 40, 55, 50)
+
 	// Test inserting an element into an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	inserted = emptyList.Insert(0, 10)
 	if inserted != true {
 		t.Errorf("Expected inserted to be true, got %v", inserted)
@@ -99,7 +108,7 @@ func TestLinkedList_Insert(t *testing.T) {
 
 func TestLinkedList_GetNode(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -141,7 +150,7 @@ func TestLinkedList_GetNode(t *testing.T) {
 
 func TestLinkedList_GetTail(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -157,7 +166,7 @@ func TestLinkedList_GetTail(t *testing.T) {
 	}
 
 	// Test getting the tail node from an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	node = emptyList.GetTail()
 	if node != nil {
 		t.Errorf("Expected node to be nil, got %v", node)
@@ -166,7 +175,7 @@ func TestLinkedList_GetTail(t *testing.T) {
 
 func TestLinkedList_GetHead(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -182,7 +191,7 @@ func TestLinkedList_GetHead(t *testing.T) {
 	}
 
 	// Test getting the head node from an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	node = emptyList.GetHead()
 	if node != nil {
 		t.Errorf("Expected node to be nil, got %v", node)
@@ -191,7 +200,7 @@ func TestLinkedList_GetHead(t *testing.T) {
 
 func TestLinkedList_Add(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Test adding an element to an empty list
 	added := ll.Add(10)
@@ -208,7 +217,7 @@ func TestLinkedList_Add(t *testing.T) {
 
 func TestLinkedList_Size(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -227,7 +236,7 @@ func TestLinkedList_Size(t *testing.T) {
 func TestLinkedList_IsEmpty(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Test checking if the linked list is empty
 	empty := ll.IsEmpty()
@@ -252,7 +261,7 @@ func TestLinkedList_IsEmpty(t *testing.T) {
 func TestLinkedList_Clear(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -274,7 +283,7 @@ func TestLinkedList_Clear(t *testing.T) {
 func TestLinkedList_Values(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -295,7 +304,7 @@ func TestLinkedList_Values(t *testing.T) {
 func TestLinkedList_String(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -316,7 +325,7 @@ func TestLinkedList_String(t *testing.T) {
 func TestLinkedList_Contains(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[any]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -341,7 +350,7 @@ func TestLinkedList_Contains(t *testing.T) {
 func TestLinkedList_IndexOf(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[any]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -366,7 +375,7 @@ func TestLinkedList_IndexOf(t *testing.T) {
 func TestLinkedList_LastIndexOf(t *testing.T) {
 
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[any]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -391,7 +400,7 @@ func TestLinkedList_LastIndexOf(t *testing.T) {
 
 func TestLinkedList_Remove(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -425,7 +434,7 @@ func TestLinkedList_Remove(t *testing.T) {
 	}
 
 	// Test removing an element from an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	removed = emptyList.Remove(10)
 	if removed != false {
 		t.Errorf("Expected removed to be false, got %v", removed)
@@ -434,8 +443,8 @@ func TestLinkedList_Remove(t *testing.T) {
 
 func TestLinkedList_Equals(t *testing.T) {
 	// Create a new linked list
-	ll1 := NewLinkedList()
-	ll2 := NewLinkedList()
+	ll1 := NewLinkedList[int]()
+	ll2 := NewLinkedList[int]()
 
 	// Add some elements to the linked lists
 	ll1.Add(10)
@@ -461,7 +470,7 @@ func TestLinkedList_Equals(t *testing.T) {
 }
 func TestLinkedList_Copy(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -490,7 +499,7 @@ func TestLinkedList_Copy(t *testing.T) {
 
 func TestLinkedList_Reverse(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -514,7 +523,7 @@ func TestLinkedList_Reverse(t *testing.T) {
 
 func TestLinkedList_GetMiddle(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -530,14 +539,14 @@ func TestLinkedList_GetMiddle(t *testing.T) {
 	}
 
 	// Test getting the middle node from an empty list
-	emptyList := NewLinkedList()
+	emptyList := NewLinkedList[int]()
 	middle = emptyList.GetMiddle()
 	if middle != nil {
 		t.Errorf("Expected middle node to be nil, got %v", middle)
 	}
 
 	// Test getting the middle node from a list with one element
-	singleElementList := NewLinkedList()
+	singleElementList := NewLinkedList[int]()
 	singleElementList.Add(10)
 	middle = singleElementList.GetMiddle()
 	if middle == nil || middle.value != 10 {
@@ -545,7 +554,7 @@ func TestLinkedList_GetMiddle(t *testing.T) {
 	}
 
 	// Test getting the middle node from a list with even number of elements
-	evenElementsList := NewLinkedList()
+	evenElementsList := NewLinkedList[int]()
 	evenElementsList.Add(10)
 	evenElementsList.Add(20)
 	evenElementsList.Add(30)
@@ -558,7 +567,7 @@ func TestLinkedList_GetMiddle(t *testing.T) {
 
 func TestLinkedList_GetNthFromEnd(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.Add(10)
@@ -595,7 +604,7 @@ func TestLinkedList_GetNthFromEnd(t *testing.T) {
 }
 func TestLinkedList_RemoveDuplicates(t *testing.T) {
 	// Create a new linked list
-	ll := NewLinkedList()
+	ll := NewLinkedList[int]()
 
 	// Add some elements to the linked list
 	ll.RemoveDuplicates()
@@ -629,3 +638,603 @@ func TestLinkedList_RemoveDuplicates(t *testing.T) {
 		}
 	}
 }
+
+// newLinkedListOf creates a linked list containing the specified values.
+func newLinkedListOf[T comparable](values ...T) *LinkedList[T] {
+	ll := NewLinkedList[T]()
+	for _, v := range values {
+		ll.Add(v)
+	}
+	return ll
+}
+
+// expectLinkedList checks that ll contains exactly the expected values and that its tail is correct.
+func expectLinkedList[T comparable](t *testing.T, ll *LinkedList[T], expected ...T) {
+	t.Helper()
+	values := ll.Values()
+	if ll.Size() != len(expected) || len(values) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, ll)
+	}
+	for i, v := range values {
+		if v != expected[i] {
+			t.Errorf("Expected element at index %d to be %v, got %v", i, expected[i], v)
+		}
+	}
+	if len(expected) > 0 && ll.GetTail().value != expected[len(expected)-1] {
+		t.Errorf("Expected tail to be %v, got %v", expected[len(expected)-1], ll.GetTail().value)
+	}
+	if len(expected) == 0 && ll.GetTail() != nil {
+		t.Errorf("Expected tail to be nil, got %v", ll.GetTail())
+	}
+}
+
+func TestLinkedList_Concat(t *testing.T) {
+	// Create two linked lists
+	ll := newLinkedListOf(1, 2)
+	other := newLinkedListOf(3, 4)
+
+	// Test concatenating the lists
+	ll.Concat(other)
+	expectLinkedList(t, ll, 1, 2, 3, 4)
+	expectLinkedList(t, other)
+
+	// Test that adding after a concat appends to the new tail
+	ll.Add(5)
+	expectLinkedList(t, ll, 1, 2, 3, 4, 5)
+
+	// Test concatenating into an empty list
+	other.Concat(ll)
+	expectLinkedList(t, other, 1, 2, 3, 4, 5)
+	expectLinkedList(t, ll)
+}
+
+func TestLinkedList_SplitAt(t *testing.T) {
+	// Create a new linked list
+	ll := newLinkedListOf(1, 2, 3, 4, 5)
+
+	// Test splitting in the middle
+	other := ll.SplitAt(2)
+	expectLinkedList(t, ll, 1, 2)
+	expectLinkedList(t, other, 3, 4, 5)
+
+	// Test splitting at both ends
+	expectLinkedList(t, ll.SplitAt(2))
+	all := ll.SplitAt(0)
+	expectLinkedList(t, ll)
+	expectLinkedList(t, all, 1, 2)
+
+	// Test splitting out of range
+	if ll.SplitAt(1) != nil {
+		t.Errorf("Expected nil for an index out of range")
+	}
+}
+
+func TestLinkedList_SpliceRange(t *testing.T) {
+	// Create two linked lists
+	ll := newLinkedListOf(1, 2, 3, 4, 5)
+	other := newLinkedListOf(10, 20)
+
+	// Test moving a range into the middle of another list
+	if !ll.SpliceRange(1, 3, other, 1) {
+		t.Errorf("Expected the splice to succeed")
+	}
+	expectLinkedList(t, ll, 1, 4, 5)
+	expectLinkedList(t, other, 10, 2, 3, 20)
+
+	// Test moving the tail of a list to the end of another list
+	ll.SpliceRange(1, 3, other, 4)
+	expectLinkedList(t, ll, 1)
+	expectLinkedList(t, other, 10, 2, 3, 20, 4, 5)
+
+	// Test moving a range within the same list
+	other.SpliceRange(4, 6, other, 0)
+	expectLinkedList(t, other, 4, 5, 10, 2, 3, 20)
+	other.SpliceRange(0, 2, other, 6)
+	expectLinkedList(t, other, 10, 2, 3, 20, 4, 5)
+
+	// Test invalid ranges
+	if other.SpliceRange(0, 3, other, 1) || ll.SpliceRange(0, 2, other, 0) || ll.SpliceRange(0, 1, other, 7) {
+		t.Errorf("Expected the splice to fail")
+	}
+}
+
+func TestLinkedList_MergeSorted(t *testing.T) {
+	// Create two sorted linked lists
+	ll := newLinkedListOf(1, 3, 5, 7)
+	other := newLinkedListOf(2, 3, 8, 9)
+	less := func(a, b int) bool { return a < b }
+
+	// Test merging the lists
+	first3 := ll.GetNode(1)
+	ll.MergeSorted(other, less)
+	expectLinkedList(t, ll, 1, 2, 3, 3, 5, 7, 8, 9)
+	expectLinkedList(t, other)
+
+	// Test that the merge is stable
+	if ll.GetNode(2) != first3 {
+		t.Errorf("Expected the 3 from the receiving list to come first")
+	}
+
+	// Test merging into an empty list
+	other.MergeSorted(ll, less)
+	expectLinkedList(t, other, 1, 2, 3, 3, 5, 7, 8, 9)
+}
+
+func TestLinkedList_HasCycle(t *testing.T) {
+	// Create a new linked list without a cycle
+	ll := newLinkedListOf(1, 2, 3, 4, 5)
+	if ll.HasCycle() || ll.CycleStart() != nil {
+		t.Errorf("Expected no cycle")
+	}
+	if err := ll.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	// Link the tail back to the third node
+	ll.GetTail().next = ll.GetNode(2)
+
+	// Test cycle detection
+	if !ll.HasCycle() {
+		t.Errorf("Expected a cycle")
+	}
+	if start := ll.CycleStart(); start != ll.GetNode(2) {
+		t.Errorf("Expected the cycle to start at 3, got %v", start)
+	}
+	if err := ll.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test that Values and String stop after Size nodes
+	if len(ll.Values()) != 5 || ll.String() != "[ 1 2 3 4 5 ]" {
+		t.Errorf("Expected [ 1 2 3 4 5 ], got %v", ll)
+	}
+
+	// Test breaking the cycle
+	if !ll.BreakCycle() {
+		t.Errorf("Expected the cycle to be broken")
+	}
+	expectLinkedList(t, ll, 1, 2, 3, 4, 5)
+	if err := ll.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if ll.BreakCycle() {
+		t.Errorf("Expected no cycle to break")
+	}
+
+	// Test a list whose tail links back to the head
+	ll.GetTail().next = ll.GetHead()
+	if ll.CycleStart() != ll.GetHead() {
+		t.Errorf("Expected the cycle to start at the head")
+	}
+}
+
+func TestLinkedList_Validate(t *testing.T) {
+	// Create a new linked list with a wrong size
+	ll := newLinkedListOf(1, 2, 3)
+	ll.size = 4
+	if err := ll.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test a wrong tail
+	ll.size = 3
+	ll.tail = ll.head
+	if err := ll.Validate(); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test an empty list
+	if err := NewLinkedList[int]().Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestLinkedList_RemoveDuplicatesModes(t *testing.T) {
+	// Test that adjacent-only removal keeps non-adjacent duplicates
+	ll := newLinkedListOf(1, 2, 1)
+	ll.RemoveDuplicates()
+	expectLinkedList(t, ll, 1, 2, 1)
+
+	// Test keeping the first occurrence
+	ll = newLinkedListOf(1, 1, 2, 1, 3, 3, 2)
+	ll.RemoveDuplicates(KeepFirst)
+	expectLinkedList(t, ll, 1, 2, 3)
+
+	// Test keeping the last occurrence, which removes the tail as well
+	ll = newLinkedListOf(1, 1, 2, 1, 3, 3, 2, 3)
+	ll.RemoveDuplicates(KeepLast)
+	expectLinkedList(t, ll, 1, 2, 3)
+	ll.Add(4)
+	expectLinkedList(t, ll, 1, 2, 3, 4)
+}
+
+func TestLinkedList_DistinctBy(t *testing.T) {
+	// Create a new linked list of words
+	ll := newLinkedListOf("apple", "avocado", "banana", "blueberry", "cherry", "apricot")
+	initial := func(value string) interface{} { return value[0] }
+
+	// Test removing adjacent words with the same initial
+	ll.DistinctBy(initial)
+	expectLinkedList(t, ll, "apple", "banana", "cherry", "apricot")
+
+	// Test keeping the last word for each initial
+	ll.DistinctBy(initial, KeepLast)
+	expectLinkedList(t, ll, "banana", "cherry", "apricot")
+}
+
+func TestLinkedList_WithEqual(t *testing.T) {
+	// Create a new linked list that compares strings case-insensitively
+	ll := NewLinkedList(WithEqual(func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}))
+	ll.Add("Apple")
+	ll.Add("banana")
+	ll.Add("APPLE")
+
+	// Test Contains, IndexOf and LastIndexOf
+	if !ll.Contains("BANANA") || ll.IndexOf("apple") != 0 || ll.LastIndexOf("apple") != 2 {
+		t.Errorf("Expected case-insensitive matches")
+	}
+
+	// Test that copies use the same equality function
+	other := ll.Copy()
+	if !other.Equals(newLinkedListOf("apple", "Banana", "apple")) {
+		t.Errorf("Expected lists to be equal ignoring case")
+	}
+
+	// Test Remove
+	if !ll.Remove("APPLE") {
+		t.Errorf("Expected apple to be removed")
+	}
+	expectLinkedList(t, ll, "banana", "APPLE")
+}
+
+func TestLinkedList_Funcs(t *testing.T) {
+	// Create a new linked list
+	ll := newLinkedListOf(1, 2, 3, 4, 5, 6)
+	even := func(value int) bool { return value%2 == 0 }
+
+	// Test ContainsFunc and IndexFunc
+	if !ll.ContainsFunc(even) || ll.IndexFunc(even) != 1 {
+		t.Errorf("Expected the first even value at index 1, got %d", ll.IndexFunc(even))
+	}
+
+	// Test RemoveFunc and RemoveAllFunc
+	if !ll.RemoveFunc(even) {
+		t.Errorf("Expected 2 to be removed")
+	}
+	expectLinkedList(t, ll, 1, 3, 4, 5, 6)
+	if removed := ll.RemoveAllFunc(even); removed != 2 {
+		t.Errorf("Expected 2 values to be removed, got %d", removed)
+	}
+	expectLinkedList(t, ll, 1, 3, 5)
+	if ll.ContainsFunc(even) || ll.RemoveFunc(even) {
+		t.Errorf("Expected no even values")
+	}
+}
+
+func TestLinkedList_TypedValues(t *testing.T) {
+	// Create a new linked list of slices, compared by their sum
+	sum := func(s []int) int {
+		total := 0
+		for _, v := range s {
+			total += v
+		}
+		return total
+	}
+	ll := NewLinkedListFunc(func(a, b []int) bool { return sum(a) == sum(b) })
+	ll.Add([]int{1, 2})
+	ll.Add([]int{4})
+
+	// Test that values and nodes are typed
+	if ll.GetHead().Value()[1] != 2 || ll.GetHead().Next().Value()[0] != 4 {
+		t.Errorf("Expected [[1 2] [4]], got %v", ll)
+	}
+	if values := ll.Values(); len(values[0]) != 2 {
+		t.Errorf("Expected the first value to have 2 elements, got %v", values[0])
+	}
+
+	// Test the equality-based methods
+	if ll.IndexOf([]int{3}) != 0 || ll.LastIndexOf([]int{2, 2}) != 1 {
+		t.Errorf("Expected matches by sum")
+	}
+	if !ll.Equals(ll.Copy()) {
+		t.Errorf("Expected the copy to be equal")
+	}
+	if !ll.Remove([]int{3}) || ll.Size() != 1 {
+		t.Errorf("Expected [1 2] to be removed")
+	}
+}
+
+func TestLinkedListConformance(t *testing.T) {
+	// Test that LinkedList behaves like every other Collection
+	testkit.TestCollection(t, func(values ...int) collections.Collection[int] { return newLinkedListOf(values...) },
+		func(i int) int { return i },
+		testkit.Ordered[int](),
+		testkit.WithClone(func(c collections.Collection[int]) collections.Collection[int] { return c.(*LinkedList[int]).Copy() }))
+}
+
+func TestLinkedListGetSetInsertAt(t *testing.T) {
+	// Test the index methods that return errors, and that they leave the list unchanged on errors
+	ll := newLinkedListOf(1, 3)
+	if err := ll.InsertAt(1, 2); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := ll.InsertAt(3, 4); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := ll.Set(0, 5); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if v, err := ll.Get(3); err != nil || v != 4 {
+		t.Errorf("Expected 4, got %v (%v)", v, err)
+	}
+	for _, index := range []int{-1, 4} {
+		if _, err := ll.Get(index); err == nil {
+			t.Errorf("Expected an error getting index %d", index)
+		}
+		if err := ll.Set(index, 0); err == nil {
+			t.Errorf("Expected an error setting index %d", index)
+		}
+	}
+	if err := ll.InsertAt(5, 0); err == nil {
+		t.Errorf("Expected an error inserting at index 5")
+	}
+	expectLinkedList(t, ll, 5, 2, 3, 4)
+}
+
+func TestLinkedListAsList(t *testing.T) {
+	// Test that the view shares the nodes of the list, and that its RemoveAt returns an error
+	ll := newLinkedListOf(1, 2, 3)
+	view := ll.AsList()
+	if err := view.RemoveAt(1); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := view.RemoveAt(2); err == nil {
+		t.Errorf("Expected an error removing index 2")
+	}
+	view.InsertAt(2, 4)
+	expectLinkedList(t, ll, 1, 3, 4)
+	if view.Len() != 3 {
+		t.Errorf("Expected the view to have 3 values, got %d", view.Len())
+	}
+
+	// Test that the list and its view behave like every other List
+	testkit.TestList(t, func() collections.List[int] { return NewLinkedList[int]().AsList() },
+		func(i int) int { return i })
+	testkit.TestList(t, func() collections.List[int] {
+		return NewLinkedList(WithEqual(func(a, b int) bool { return a%100 == b%100 })).AsList()
+	}, func(i int) int { return i }, testkit.WithEquivalent(func(i int) int { return i + 100 }))
+}
+
+func FuzzLinkedList(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 1, 3, 2, 1, 3, 2})
+	f.Add([]byte{0, 5, 0, 5, 4, 5, 5, 0, 6, 0, 0, 7})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a LinkedList and to a slice, and compare them after every operation
+		ll := NewLinkedList[int]()
+		var model []int
+		for _, op := range testkit.DecodeOps(data, 7) {
+			index := op.Arg % (len(model) + 2)
+			switch op.Code {
+			case 0:
+				ll.Add(op.Arg)
+				model = append(model, op.Arg)
+			case 1:
+				ok := ll.Insert(index, op.Arg)
+				if ok != (index >= 0 && index <= len(model)) {
+					t.Fatalf("Expected Insert(%d) to return %v, got %v", index, !ok, ok)
+				}
+				if ok {
+					model = slices.Insert(model, index, op.Arg)
+				}
+			case 2:
+				node := ll.RemoveAt(index)
+				if (node != nil) != (index >= 0 && index < len(model)) {
+					t.Fatalf("Expected RemoveAt(%d) on %v to return a node: %v", index, model, node != nil)
+				}
+				if node != nil {
+					if node.value != model[index] {
+						t.Fatalf("Expected RemoveAt(%d) to remove %v, got %v", index, model[index], node.value)
+					}
+					model = slices.Delete(model, index, index+1)
+				}
+			case 3:
+				i := slices.Index(model, op.Arg)
+				if ll.Remove(op.Arg) != (i >= 0) {
+					t.Fatalf("Expected Remove(%d) on %v to return %v", op.Arg, model, i >= 0)
+				}
+				if i >= 0 {
+					model = slices.Delete(model, i, i+1)
+				}
+			case 4:
+				if got := ll.IndexOf(op.Arg); got != slices.Index(model, op.Arg) {
+					t.Fatalf("Expected IndexOf(%d) on %v to be %d, got %d", op.Arg, model, slices.Index(model, op.Arg), got)
+				}
+			case 5:
+				ll.Reverse()
+				slices.Reverse(model)
+			case 6:
+				node := ll.GetNode(index)
+				if (node != nil) != (index >= 0 && index < len(model)) || node != nil && node.value != model[index] {
+					t.Fatalf("Expected GetNode(%d) on %v to match, got %v", index, model, node)
+				}
+			}
+			if !slices.Equal(ll.Values(), model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, ll.Values())
+			}
+			if err := ll.Validate(); err != nil {
+				t.Fatalf("Expected a valid list after %+v, got %v", op, err)
+			}
+		}
+	})
+}
+
+// benchSink keeps the compiler from optimizing away the results of benchmarked operations.
+var benchSink interface{}
+
+// newBenchLinkedList returns a linked list holding the integers from 0 to n-1.
+func newBenchLinkedList(n int) *LinkedList[int] {
+	ll := NewLinkedList[int]()
+	for i := 0; i < n; i++ {
+		ll.Add(i)
+	}
+	return ll
+}
+
+// newBenchList returns a container/list holding the integers from 0 to n-1.
+func newBenchList(n int) *list.List {
+	l := list.New()
+	for i := 0; i < n; i++ {
+		l.PushBack(i)
+	}
+	return l
+}
+
+// newBenchSlice returns a slice holding the integers from 0 to n-1.
+func newBenchSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func BenchmarkLinkedListAddRemoveFront(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ll.Insert(0, i)
+			benchSink = ll.RemoveAt(0)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushFront(i)
+			benchSink = l.Remove(l.Front())
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = slices.Insert(s, 0, i)
+			benchSink = s[0]
+			s = slices.Delete(s, 0, 1)
+		}
+	})
+}
+
+func BenchmarkLinkedListAdd(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ll.Add(i)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushBack(i)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = append(s, i)
+		}
+	})
+}
+
+func BenchmarkLinkedListGetNode(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = ll.GetNode(i % n)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			e := l.Front()
+			for j := 0; j < i%n; j++ {
+				e = e.Next()
+			}
+			benchSink = e
+		}
+	})
+}
+
+func BenchmarkLinkedListContains(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = ll.Contains(n / 2)
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			found := false
+			for e := l.Front(); e != nil && !found; e = e.Next() {
+				found = e.Value.(int) == n/2
+			}
+			benchSink = found
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = slices.Contains(s, n/2)
+		}
+	})
+}
+
+func BenchmarkLinkedListReverse(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ll.Reverse()
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchSlice(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			slices.Reverse(s)
+		}
+	})
+}
+
+func BenchmarkLinkedListValues(b *testing.B) {
+	testkit.Bench(b, "LinkedList", func(b *testing.B, n int) {
+		ll := newBenchLinkedList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = ll.Values()
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			values := make([]int, 0, l.Len())
+			for e := l.Front(); e != nil; e = e.Next() {
+				values = append(values, e.Value.(int))
+			}
+			benchSink = values
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package linked_list
+
+// EqualFunc reports whether two values are equal.
+type EqualFunc[T any] func(a, b T) bool
+
+// Option configures a LinkedList or a DoublyLinkedList.
+type Option[T any] func(*config[T])
+
+// config holds the settings of a list.
+type config[T any] struct {
+	equal EqualFunc[T]
+}
+
+// WithEqual sets the function the list uses to compare values, instead of ==, in methods such as
+// Contains, IndexOf and Remove. It allows matching structs by a field.
+// RemoveDuplicates and DistinctBy still hash values or keys.
+func WithEqual[T any](equal EqualFunc[T]) Option[T] {
+	return func(c *config[T]) {
+		c.equal = equal
+	}
+}
+
+// newConfig returns the configuration built from opts, comparing values with equal by default.
+func newConfig[T any](opts []Option[T], equal EqualFunc[T]) config[T] {
+	c := config[T]{equal: equal}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// equalComparable compares two comparable values with ==.
+func equalComparable[T comparable](a, b T) bool {
+	return a == b
+}
+
+// equals compares two values with equal. If equal is nil, which happens for lists that were not
+// created by a constructor, it compares the dynamic values with ==, which panics if they are not comparable.
+func (equal EqualFunc[T]) equals(a, b T) bool {
+	if equal == nil {
+		return any(a) == any(b)
+	}
+	return equal(a, b)
+}
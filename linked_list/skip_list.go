@@ -0,0 +1,360 @@
+package linked_list
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"goCollections/collections"
+)
+
+const (
+	// skipListMaxLevel is the maximum number of levels of a skip list node.
+	skipListMaxLevel = 32
+	// skipListP is the probability that a node is promoted to the next level.
+	skipListP = 0.25
+)
+
+// skipListLevel is a forward link of a skip list node at one level.
+type skipListLevel[K cmp.Ordered, V any] struct {
+	node *skipListNode[K, V] // The next node at this level.
+	span int                 // The number of level 0 links skipped by following node.
+}
+
+// skipListNode represents a node in a skip list.
+type skipListNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []skipListLevel[K, V]
+}
+
+// SkipList represents a sorted map from keys to values implemented as an indexable skip list.
+// Search, Insert, Delete and rank queries run in expected O(log n) time.
+// A SkipList is safe for concurrent use by multiple goroutines.
+type SkipList[K cmp.Ordered, V any] struct {
+	mu     sync.RWMutex
+	header *skipListNode[K, V] // A sentinel node that links to the first node at every level.
+	level  int                 // The number of levels in use.
+	size   int                 // The number of nodes in the skip list.
+	rand   *rand.Rand          // The source of random node levels.
+}
+
+// A SkipList is a collection of its values, in key order.
+var _ collections.Collection[int] = (*SkipList[string, int])(nil)
+
+// NewSkipList creates and returns a new empty SkipList seeded from the current time.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListWithSeed[K, V](time.Now().UnixNano())
+}
+
+// NewSkipListWithSeed creates and returns a new empty SkipList whose node levels are drawn
+// from a random source with the given seed. Skip lists built with the same seed and the
+// same sequence of operations have the same structure, which keeps tests deterministic.
+func NewSkipListWithSeed[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		header: &skipListNode[K, V]{next: make([]skipListLevel[K, V], skipListMaxLevel)},
+		level:  1,
+		rand:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// randomLevel returns the number of levels of a new node.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.rand.Float64() < skipListP {
+		level++
+	}
+	return level
+}
+
+// Insert adds the key with the specified value to the skip list.
+// If the key already exists, its value is replaced.
+// It returns true if the key was added, or false if an existing value was replaced.
+func (s *SkipList[K, V]) Insert(key K, value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	var rank [skipListMaxLevel]int
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i].node != nil && x.next[i].node.key < key {
+			rank[i] += x.next[i].span
+			x = x.next[i].node
+		}
+		update[i] = x
+	}
+
+	if next := x.next[0].node; next != nil && next.key == key {
+		next.value = value
+		return false
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.header
+			update[i].next[i].span = s.size
+		}
+		s.level = level
+	}
+
+	node := &skipListNode[K, V]{key: key, value: value, next: make([]skipListLevel[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i].node = update[i].next[i].node
+		update[i].next[i].node = node
+		node.next[i].span = update[i].next[i].span - (rank[0] - rank[i])
+		update[i].next[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].next[i].span++
+	}
+
+	s.size++
+	return true
+}
+
+// Delete removes the key from the skip list.
+// It returns true if the key was found and removed, otherwise false.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [skipListMaxLevel]*skipListNode[K, V]
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && x.next[i].node.key < key {
+			x = x.next[i].node
+		}
+		update[i] = x
+	}
+
+	x = x.next[0].node
+	if x == nil || x.key != key {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i].node == x {
+			update[i].next[i].span += x.next[i].span - 1
+			update[i].next[i].node = x.next[i].node
+		} else {
+			update[i].next[i].span--
+		}
+	}
+	for s.level > 1 && s.header.next[s.level-1].node == nil {
+		s.level--
+	}
+
+	s.size--
+	return true
+}
+
+// findGreaterOrEqual returns the first node whose key is not less than key, or nil if there is none.
+func (s *SkipList[K, V]) findGreaterOrEqual(key K) *skipListNode[K, V] {
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && x.next[i].node.key < key {
+			x = x.next[i].node
+		}
+	}
+	return x.next[0].node
+}
+
+// Search returns the value stored for the key.
+// The second result is false if the key is not in the skip list.
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if x := s.findGreaterOrEqual(key); x != nil && x.key == key {
+		return x.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if the skip list contains the key.
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Search(key)
+	return ok
+}
+
+// Rank returns the zero-based position of the key in the sorted order of the skip list.
+// The second result is false if the key is not in the skip list.
+func (s *SkipList[K, V]) Rank(key K) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rank := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && x.next[i].node.key <= key {
+			rank += x.next[i].span
+			x = x.next[i].node
+		}
+		if x != s.header && x.key == key {
+			return rank - 1, true
+		}
+	}
+	return 0, false
+}
+
+// ByRank returns the key and value at the zero-based position rank in the sorted order of the skip list.
+// It returns an error if the rank is out of range.
+func (s *SkipList[K, V]) ByRank(rank int) (K, V, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if rank < 0 || rank >= s.size {
+		var key K
+		var value V
+		return key, value, errors.New("index out of range")
+	}
+	traversed := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && traversed+x.next[i].span <= rank+1 {
+			traversed += x.next[i].span
+			x = x.next[i].node
+		}
+		if traversed == rank+1 {
+			break
+		}
+	}
+	return x.key, x.value, nil
+}
+
+// Range calls fn for each key and value with from <= key < to, in ascending key order.
+// Iteration stops early if fn returns false. The skip list must not be modified by fn.
+func (s *SkipList[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for x := s.findGreaterOrEqual(from); x != nil && x.key < to; x = x.next[0].node {
+		if !fn(x.key, x.value) {
+			return
+		}
+	}
+}
+
+// ForEach calls fn for each key and value in ascending key order.
+// Iteration stops early if fn returns false. The skip list must not be modified by fn.
+func (s *SkipList[K, V]) ForEach(fn func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for x := s.header.next[0].node; x != nil; x = x.next[0].node {
+		if !fn(x.key, x.value) {
+			return
+		}
+	}
+}
+
+// Keys returns a slice containing the keys in the skip list in ascending order.
+func (s *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, s.Size())
+	s.ForEach(func(key K, value V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice containing the values in the skip list in ascending key order.
+func (s *SkipList[K, V]) Values() []V {
+	values := make([]V, 0, s.Size())
+	s.ForEach(func(key K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Size returns the number of keys in the skip list.
+func (s *SkipList[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.size
+}
+
+// Len returns the number of keys in the skip list. It is the same as Size.
+func (s *SkipList[K, V]) Len() int {
+	return s.Size()
+}
+
+// IsEmpty returns true if the skip list is empty, false otherwise.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all keys from the skip list.
+func (s *SkipList[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.header.next = make([]skipListLevel[K, V], skipListMaxLevel)
+	s.level = 1
+	s.size = 0
+}
+
+// Iterator returns an iterator positioned before the first key of the skip list.
+func (s *SkipList[K, V]) Iterator() *SkipListIterator[K, V] {
+	return &SkipListIterator[K, V]{list: s, node: s.header}
+}
+
+// IteratorFrom returns an iterator positioned before the first key that is not less than key.
+func (s *SkipList[K, V]) IteratorFrom(key K) *SkipListIterator[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &SkipListIterator[K, V]{list: s, next: s.findGreaterOrEqual(key), seeked: true}
+}
+
+// SkipListIterator iterates forward over the keys of a SkipList in ascending order.
+// Each step takes the skip list's read lock; keys inserted or deleted while iterating
+// may or may not be visited.
+type SkipListIterator[K cmp.Ordered, V any] struct {
+	list   *SkipList[K, V]
+	node   *skipListNode[K, V] // The current node, or the header before the first call to Next.
+	next   *skipListNode[K, V] // The first node of an iterator created by IteratorFrom.
+	seeked bool                // Whether next holds the first node to visit.
+	key    K                   // The key of the current node, copied under the read lock.
+	value  V                   // The value of the current node, copied under the read lock.
+}
+
+// Next advances the iterator to the next key.
+// It returns false when there are no more keys.
+func (it *SkipListIterator[K, V]) Next() bool {
+	it.list.mu.RLock()
+	defer it.list.mu.RUnlock()
+
+	if it.seeked {
+		it.node, it.next, it.seeked = it.next, nil, false
+	} else if it.node != nil {
+		it.node = it.node.next[0].node
+	}
+	if it.node == nil {
+		return false
+	}
+	it.key, it.value = it.node.key, it.node.value
+	return true
+}
+
+// Key returns the key at the current position of the iterator.
+func (it *SkipListIterator[K, V]) Key() K {
+	return it.key
+}
+
+// Value returns the value at the current position of the iterator, as it was when Next moved to it.
+// A later Insert of the same key does not change it.
+func (it *SkipListIterator[K, V]) Value() V {
+	return it.value
+}
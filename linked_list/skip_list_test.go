@@ -0,0 +1,337 @@
+package linked_list
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestSkipListInsertSearch(t *testing.T) {
+	// Create a new instance of the SkipList struct
+	s := NewSkipListWithSeed[int, string](1)
+
+	// Test inserting keys out of order
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		if !s.Insert(k, "v") {
+			t.Errorf("Expected key %d to be added", k)
+		}
+	}
+	if s.Size() != 5 {
+		t.Errorf("Expected size to be 5, got %d", s.Size())
+	}
+
+	// Test replacing a value
+	if s.Insert(3, "three") {
+		t.Errorf("Expected key 3 to be replaced")
+	}
+	if v, ok := s.Search(3); !ok || v != "three" {
+		t.Errorf("Expected value of 3 to be three, got %v", v)
+	}
+	if s.Size() != 5 {
+		t.Errorf("Expected size to be 5, got %d", s.Size())
+	}
+
+	// Test searching for a missing key
+	if _, ok := s.Search(4); ok {
+		t.Errorf("Expected key 4 not to be found")
+	}
+
+	// Test sorted order
+	expected := []int{1, 3, 5, 7, 9}
+	for i, k := range s.Keys() {
+		if k != expected[i] {
+			t.Errorf("Expected key at index %d to be %d, got %d", i, expected[i], k)
+		}
+	}
+}
+
+func TestSkipListDelete(t *testing.T) {
+	// Create a new SkipList
+	s := NewSkipListWithSeed[int, int](2)
+	for i := 0; i < 100; i++ {
+		s.Insert(i, i*i)
+	}
+
+	// Test deleting every even key
+	for i := 0; i < 100; i += 2 {
+		if !s.Delete(i) {
+			t.Errorf("Expected key %d to be deleted", i)
+		}
+	}
+	if s.Delete(0) {
+		t.Errorf("Expected key 0 to be missing")
+	}
+	if s.Size() != 50 {
+		t.Errorf("Expected size to be 50, got %d", s.Size())
+	}
+	if s.Contains(10) || !s.Contains(11) {
+		t.Errorf("Expected 10 to be deleted and 11 to remain")
+	}
+
+	// Test Clear
+	s.Clear()
+	if !s.IsEmpty() || s.Contains(11) {
+		t.Errorf("Expected skip list to be empty")
+	}
+}
+
+func TestSkipListRank(t *testing.T) {
+	// Create a new SkipList with scores as keys
+	s := NewSkipListWithSeed[int, string](3)
+	for i := 0; i < 200; i++ {
+		s.Insert(i*10, "player")
+	}
+	s.Delete(50)
+
+	// Test Rank
+	if r, ok := s.Rank(0); !ok || r != 0 {
+		t.Errorf("Expected rank of 0 to be 0, got %d", r)
+	}
+	if r, ok := s.Rank(60); !ok || r != 5 {
+		t.Errorf("Expected rank of 60 to be 5, got %d", r)
+	}
+	if r, ok := s.Rank(1990); !ok || r != 198 {
+		t.Errorf("Expected rank of 1990 to be 198, got %d", r)
+	}
+	if _, ok := s.Rank(55); ok {
+		t.Errorf("Expected 55 to have no rank")
+	}
+
+	// Test ByRank matches Rank for every key
+	for i, k := range s.Keys() {
+		key, _, err := s.ByRank(i)
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if key != k {
+			t.Errorf("Expected key at rank %d to be %d, got %d", i, k, key)
+		}
+	}
+
+	// Test ByRank out of range
+	if _, _, err := s.ByRank(199); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestSkipListRange(t *testing.T) {
+	// Create a new SkipList
+	s := NewSkipListWithSeed[string, int](4)
+	for i, k := range []string{"apple", "banana", "cherry", "date", "elderberry"} {
+		s.Insert(k, i)
+	}
+
+	// Test Range
+	keys := []string{}
+	s.Range("b", "d", func(key string, value int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if len(keys) != 2 || keys[0] != "banana" || keys[1] != "cherry" {
+		t.Errorf("Expected [banana cherry], got %v", keys)
+	}
+
+	// Test stopping early
+	count := 0
+	s.ForEach(func(key string, value int) bool {
+		count++
+		return count < 3
+	})
+	if count != 3 {
+		t.Errorf("Expected iteration to stop after 3 keys, got %d", count)
+	}
+}
+
+func TestSkipListIterator(t *testing.T) {
+	// Create a new SkipList
+	s := NewSkipListWithSeed[int, int](5)
+	for i := 1; i <= 5; i++ {
+		s.Insert(i, i*100)
+	}
+
+	// Test iterating over every key
+	it := s.Iterator()
+	expected := 1
+	for it.Next() {
+		if it.Key() != expected || it.Value() != expected*100 {
+			t.Errorf("Expected %d => %d, got %d => %d", expected, expected*100, it.Key(), it.Value())
+		}
+		expected++
+	}
+	if expected != 6 {
+		t.Errorf("Expected to visit 5 keys, visited %d", expected-1)
+	}
+
+	// Test iterating from a key
+	it = s.IteratorFrom(3)
+	if !it.Next() || it.Key() != 3 {
+		t.Errorf("Expected first key to be 3")
+	}
+
+	// Test iterating an empty skip list
+	if NewSkipList[int, int]().Iterator().Next() {
+		t.Errorf("Expected an empty iterator")
+	}
+}
+
+func TestSkipListDeterministic(t *testing.T) {
+	// Build two skip lists with the same seed
+	s1 := NewSkipListWithSeed[int, int](42)
+	s2 := NewSkipListWithSeed[int, int](42)
+	for i := 0; i < 100; i++ {
+		s1.Insert(i, i)
+		s2.Insert(i, i)
+	}
+
+	// Test that they have the same structure
+	if s1.level != s2.level {
+		t.Errorf("Expected the same number of levels, got %d and %d", s1.level, s2.level)
+	}
+	n1, n2 := s1.header.next[0].node, s2.header.next[0].node
+	for n1 != nil {
+		if len(n1.next) != len(n2.next) {
+			t.Errorf("Expected node %d to have the same level in both skip lists", n1.key)
+		}
+		n1, n2 = n1.next[0].node, n2.next[0].node
+	}
+}
+
+func TestSkipListConcurrent(t *testing.T) {
+	// Insert from several goroutines at once
+	s := NewSkipListWithSeed[int, int](6)
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				s.Insert(g*250+i, i)
+				s.Search(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if s.Size() != 1000 {
+		t.Errorf("Expected size to be 1000, got %d", s.Size())
+	}
+	if r, _ := s.Rank(999); r != 999 {
+		t.Errorf("Expected rank of 999 to be 999, got %d", r)
+	}
+}
+
+func TestSkipListIteratorConcurrentInsert(t *testing.T) {
+	// Iterate while another goroutine overwrites the values of the same keys
+	s := NewSkipListWithSeed[int, int](7)
+	for i := 0; i < 100; i++ {
+		s.Insert(i, i)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for round := 1; round <= 20; round++ {
+			for i := 0; i < 100; i++ {
+				s.Insert(i, i+round*1000)
+			}
+		}
+	}()
+	for round := 0; round < 20; round++ {
+		it := s.Iterator()
+		expected := 0
+		for it.Next() {
+			if it.Key() != expected || it.Value()%1000 != expected {
+				t.Errorf("Expected key %d with a value ending in %d, got %d => %d", expected, expected, it.Key(), it.Value())
+			}
+			expected++
+		}
+	}
+	<-done
+
+	// Test that the value is the one seen by Next, not a later overwrite
+	it := s.Iterator()
+	it.Next()
+	s.Insert(0, -1)
+	if it.Value() != 20000 {
+		t.Errorf("Expected value 20000, got %d", it.Value())
+	}
+}
+
+func TestSkipListConformance(t *testing.T) {
+	// Test that SkipList behaves like every other Collection of its values, in key order
+	testkit.TestCollection(t, func(values ...int) collections.Collection[int] {
+		s := NewSkipListWithSeed[int, int](1)
+		for _, v := range values {
+			s.Insert(v, v)
+		}
+		return s
+	}, func(i int) int { return i }, testkit.Ordered[int]())
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func FuzzSkipList(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 1, 2, 2, 3, 3, 1, 4, 0})
+	f.Add([]byte{0, 9, 0, 9, 0, 200, 3, 200, 4, 1, 1, 9})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a SkipList and to a map, and compare them after every operation
+		s := NewSkipListWithSeed[int, int](int64(len(data)))
+		model := make(map[int]int)
+		for i, op := range testkit.DecodeOps(data, 5) {
+			keys := sortedKeys(model)
+			switch op.Code {
+			case 0:
+				_, exists := model[op.Arg]
+				if s.Insert(op.Arg, i) == exists {
+					t.Fatalf("Expected Insert(%d) on %v to return %v", op.Arg, model, !exists)
+				}
+				model[op.Arg] = i
+			case 1:
+				_, exists := model[op.Arg]
+				if s.Delete(op.Arg) != exists {
+					t.Fatalf("Expected Delete(%d) on %v to return %v", op.Arg, model, exists)
+				}
+				delete(model, op.Arg)
+			case 2:
+				expected, exists := model[op.Arg]
+				if v, ok := s.Search(op.Arg); ok != exists || v != expected {
+					t.Fatalf("Expected Search(%d) on %v to be %v, %v, got %v, %v", op.Arg, model, expected, exists, v, ok)
+				}
+			case 3:
+				expected, exists := slices.BinarySearch(keys, op.Arg)
+				if !exists {
+					expected = 0
+				}
+				if rank, ok := s.Rank(op.Arg); ok != exists || rank != expected {
+					t.Fatalf("Expected Rank(%d) on %v to be %v, %v, got %v, %v", op.Arg, keys, expected, exists, rank, ok)
+				}
+			case 4:
+				rank := op.Arg % (len(keys) + 1)
+				key, value, err := s.ByRank(rank)
+				inRange := rank >= 0 && rank < len(keys)
+				if (err == nil) != inRange || inRange && (key != keys[rank] || value != model[key]) {
+					t.Fatalf("Expected ByRank(%d) on %v to match, got %v, %v (%v)", rank, keys, key, value, err)
+				}
+			}
+			keys = sortedKeys(model)
+			if !slices.Equal(s.Keys(), keys) || s.Size() != len(model) {
+				t.Fatalf("Expected keys %v after %+v, got %v", keys, op, s.Keys())
+			}
+			for j, v := range s.Values() {
+				if v != model[keys[j]] {
+					t.Fatalf("Expected value %v for key %v after %+v, got %v", model[keys[j]], keys[j], op, v)
+				}
+			}
+		}
+	})
+}
@@ -3,46 +3,96 @@ package queue
 
 import (
 	"errors"
+
+	"goCollections/collections"
 )
 
-// Queue represents a simple queue structure.
-type Queue struct {
-	values []interface{}
+// Queue represents a simple queue structure holding elements of type T.
+type Queue[T any] struct {
+	values []T
 	size   int
 }
 
+// AnyQueue is a queue that holds elements of any type, like the queue did before it was generic.
+// Go does not allow an alias to share the name of the generic type, so existing code that used
+// the untyped queue should use AnyQueue and NewAnyQueue.
+type AnyQueue = Queue[any]
+
+var _ collections.Queue[int] = (*Queue[int])(nil)
+
 // NewQueue creates and returns a new empty queue.
-func NewQueue() *Queue {
-	return &Queue{
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{
 		size: 0,
 	}
 }
 
+// NewAnyQueue creates and returns a new empty queue that holds elements of any type.
+func NewAnyQueue() *AnyQueue {
+	return NewQueue[any]()
+}
+
 // Enqueue adds a new element to the end of the queue.
-func (q *Queue) Enqueue(value interface{}) {
+func (q *Queue[T]) Enqueue(value T) {
 	q.values = append(q.values, value)
 	q.size++
 }
 
+// EnqueueAll adds the given elements to the end of the queue, in order.
+func (q *Queue[T]) EnqueueAll(values ...T) {
+	q.values = append(q.values, values...)
+	q.size += len(values)
+}
+
 // Dequeue removes and returns the element from the front of the queue.
 // It returns an error if the queue is empty.
-func (q *Queue) Dequeue() (interface{}, error) {
+func (q *Queue[T]) Dequeue() (T, error) {
 	if q.size == 0 {
-		return nil, errors.New("queue is empty")
+		var zero T
+		return zero, errors.New("queue is empty")
 	}
 
-	lastElement := q.values[0]
+	var zero T
+	firstElement := q.values[0]
+	q.values[0] = zero
 	q.values = q.values[1:]
 	q.size--
 
-	return lastElement, nil
+	return firstElement, nil
+}
+
+// TryDequeue removes and returns the element from the front of the queue.
+// It returns false if the queue is empty.
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	value, err := q.Dequeue()
+	return value, err == nil
+}
+
+// DequeueN removes and returns up to n elements from the front of the queue, in order.
+// It returns fewer than n elements if the queue holds fewer, and nil if n is not positive.
+func (q *Queue[T]) DequeueN(n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	if n > q.size {
+		n = q.size
+	}
+
+	values := make([]T, n)
+	copy(values, q.values[:n])
+	clear(q.values[:n])
+	q.values = q.values[n:]
+	q.size -= n
+
+	return values
 }
 
 // Head returns the element at the front of the queue without removing it.
 // It returns an error if the queue is empty.
-func (q *Queue) Head() (interface{}, error) {
+func (q *Queue[T]) Head() (T, error) {
 	if q.size == 0 {
-		return nil, errors.New("queue is empty")
+		var zero T
+		return zero, errors.New("queue is empty")
 	}
 
 	return q.values[0], nil
@@ -50,26 +100,50 @@ func (q *Queue) Head() (interface{}, error) {
 
 // Tail returns the element at the end of the queue without removing it.
 // It returns an error if the queue is empty.
-func (q *Queue) Tail() (interface{}, error) {
+func (q *Queue[T]) Tail() (T, error) {
 	if q.size == 0 {
-		return nil, errors.New("queue is empty")
+		var zero T
+		return zero, errors.New("queue is empty")
 	}
 
 	return q.values[q.size-1], nil
 }
 
+// Peek returns the element offset positions from the front of the queue without removing it.
+// Peek(0) is the same as Head. It returns an error if the offset is out of range.
+func (q *Queue[T]) Peek(offset int) (T, error) {
+	if offset < 0 || offset >= q.size {
+		var zero T
+		return zero, errors.New("index out of range")
+	}
+
+	return q.values[offset], nil
+}
+
 // IsEmpty returns true if the queue is empty, otherwise false.
-func (q *Queue) IsEmpty() bool {
+func (q *Queue[T]) IsEmpty() bool {
 	return q.size == 0
 }
 
 // Size returns the number of elements in the queue.
-func (q *Queue) Size() int {
+func (q *Queue[T]) Size() int {
+	return q.size
+}
+
+// Len returns the number of elements in the queue. It is the same as Size.
+func (q *Queue[T]) Len() int {
 	return q.size
 }
 
+// Values returns a new slice containing the elements of the queue, from front to back.
+func (q *Queue[T]) Values() []T {
+	values := make([]T, q.size)
+	copy(values, q.values)
+	return values
+}
+
 // Clear removes all elements from the queue, making it empty.
-func (q *Queue) Clear() {
+func (q *Queue[T]) Clear() {
 	q.values = nil
 	q.size = 0
 }
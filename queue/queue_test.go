@@ -0,0 +1,307 @@
+package queue
+
+import (
+	"container/list"
+	"slices"
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestTryDequeue(t *testing.T) {
+	// Test TryDequeue on an empty queue and on a queue with elements
+	q := NewQueue[int]()
+	if v, ok := q.TryDequeue(); ok || v != 0 {
+		t.Errorf("Expected 0, false on an empty queue, got %v, %v", v, ok)
+	}
+	q.Enqueue(1)
+	q.Enqueue(2)
+	if v, ok := q.TryDequeue(); !ok || v != 1 {
+		t.Errorf("Expected 1, true, got %v, %v", v, ok)
+	}
+	if q.Size() != 1 {
+		t.Errorf("Expected size 1, got %v", q.Size())
+	}
+}
+
+func TestDequeueN(t *testing.T) {
+	// Test DequeueN with counts below, at and above the size of the queue
+	tests := []struct {
+		name      string
+		n         int
+		expected  []int
+		remaining []int
+	}{
+		{"negative", -1, nil, []int{1, 2, 3}},
+		{"zero", 0, nil, []int{1, 2, 3}},
+		{"fewer", 2, []int{1, 2}, []int{3}},
+		{"all", 3, []int{1, 2, 3}, []int{}},
+		{"more", 5, []int{1, 2, 3}, []int{}},
+	}
+	for _, test := range tests {
+		q := NewQueue[int]()
+		q.EnqueueAll(1, 2, 3)
+		values := q.DequeueN(test.n)
+		if !slices.Equal(values, test.expected) || test.expected == nil && values != nil {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, values)
+		}
+		if remaining := q.Values(); !slices.Equal(remaining, test.remaining) {
+			t.Errorf("%s: Expected %v to remain, got %v", test.name, test.remaining, remaining)
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	// Test Peek with offsets inside and outside the queue
+	q := NewQueue[string]()
+	q.EnqueueAll("a", "b", "c")
+	tests := []struct {
+		offset   int
+		expected string
+		ok       bool
+	}{
+		{0, "a", true},
+		{2, "c", true},
+		{-1, "", false},
+		{3, "", false},
+		{10, "", false},
+	}
+	for _, test := range tests {
+		v, err := q.Peek(test.offset)
+		if (err == nil) != test.ok || v != test.expected {
+			t.Errorf("Peek(%d): Expected %q, no error: %v, got %q (%v)", test.offset, test.expected, test.ok, v, err)
+		}
+	}
+	if q.Size() != 3 {
+		t.Errorf("Expected Peek not to remove elements, got size %v", q.Size())
+	}
+	if _, err := NewQueue[int]().Peek(0); err == nil {
+		t.Errorf("Expected an error for Peek on an empty queue")
+	}
+}
+
+func TestEnqueueAll(t *testing.T) {
+	// Test that EnqueueAll keeps the order of the elements after the existing ones
+	tests := []struct {
+		initial  []int
+		added    []int
+		expected []int
+	}{
+		{nil, []int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{1}, []int{2, 3}, []int{1, 2, 3}},
+		{[]int{1, 2}, nil, []int{1, 2}},
+	}
+	for _, test := range tests {
+		q := NewQueue[int]()
+		for _, v := range test.initial {
+			q.Enqueue(v)
+		}
+		q.EnqueueAll(test.added...)
+		if values := q.Values(); !slices.Equal(values, test.expected) {
+			t.Errorf("Expected %v, got %v", test.expected, values)
+		}
+		for _, expected := range test.expected {
+			if v, _ := q.Dequeue(); v != expected {
+				t.Errorf("Expected to dequeue %v, got %v", expected, v)
+			}
+		}
+	}
+}
+
+func TestAnyQueue(t *testing.T) {
+	// Test that an AnyQueue holds elements of different types
+	q := NewAnyQueue()
+	q.Enqueue(1)
+	q.Enqueue("two")
+	if v, _ := q.Dequeue(); v != 1 {
+		t.Errorf("Expected 1, got %v", v)
+	}
+	if v, _ := q.Dequeue(); v != "two" {
+		t.Errorf("Expected two, got %v", v)
+	}
+}
+
+func TestQueueConformance(t *testing.T) {
+	// Test that Queue behaves like every other Queue
+	testkit.TestQueue(t, func() collections.Queue[int] { return NewQueue[int]() },
+		func(i int) int { return i })
+
+	// Test the battery with values that == cannot compare
+	testkit.TestQueue(t, func() collections.Queue[[]int] { return NewQueue[[]int]() },
+		func(i int) []int { return []int{i} },
+		testkit.WithEqual(func(a, b []int) bool { return a[0] == b[0] }))
+}
+
+func FuzzQueue(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 1, 0, 4, 0, 5, 1, 6, 0, 7, 0})
+	f.Add([]byte{3, 3, 2, 0, 4, 2, 0, 9, 1, 0, 2, 0, 1, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a Queue and to a slice, and compare them after every operation
+		q := NewQueue[int]()
+		var model []int
+		for _, op := range testkit.DecodeOps(data, 8) {
+			index := op.Arg % (len(model) + 2)
+			switch op.Code {
+			case 0:
+				q.Enqueue(op.Arg)
+				model = append(model, op.Arg)
+			case 1:
+				v, err := q.Dequeue()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[0] {
+					t.Fatalf("Expected Dequeue on %v to match, got %v (%v)", model, v, err)
+				}
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			case 2:
+				v, ok := q.TryDequeue()
+				if ok != (len(model) > 0) || ok && v != model[0] {
+					t.Fatalf("Expected TryDequeue on %v to match, got %v, %v", model, v, ok)
+				}
+				if ok {
+					model = model[1:]
+				}
+			case 3:
+				// Enqueue a few consecutive values at once
+				values := []int{op.Arg, op.Arg + 1, op.Arg + 2, op.Arg + 3}[:op.Arg&3]
+				q.EnqueueAll(values...)
+				model = append(model, values...)
+			case 4:
+				values := q.DequeueN(index)
+				n := min(max(index, 0), len(model))
+				if !slices.Equal(values, model[:n]) {
+					t.Fatalf("Expected DequeueN(%d) on %v to be %v, got %v", index, model, model[:n], values)
+				}
+				model = model[n:]
+			case 5:
+				v, err := q.Peek(index)
+				inRange := index >= 0 && index < len(model)
+				if (err == nil) != inRange || inRange && v != model[index] {
+					t.Fatalf("Expected Peek(%d) on %v to match, got %v (%v)", index, model, v, err)
+				}
+			case 6:
+				v, err := q.Head()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[0] {
+					t.Fatalf("Expected Head on %v to match, got %v (%v)", model, v, err)
+				}
+			case 7:
+				v, err := q.Tail()
+				if (err == nil) != (len(model) > 0) || len(model) > 0 && v != model[len(model)-1] {
+					t.Fatalf("Expected Tail on %v to match, got %v (%v)", model, v, err)
+				}
+			}
+			if !slices.Equal(q.Values(), model) || q.Size() != len(model) {
+				t.Fatalf("Expected %v after %+v, got %v", model, op, q.Values())
+			}
+		}
+	})
+}
+
+// benchSink keeps the compiler from optimizing away the results of benchmarked operations.
+var benchSink interface{}
+
+// newBenchQueue returns a queue holding the integers from 0 to n-1.
+func newBenchQueue(n int) *Queue[int] {
+	q := NewQueue[int]()
+	for i := 0; i < n; i++ {
+		q.Enqueue(i)
+	}
+	return q
+}
+
+// newBenchList returns a container/list holding the integers from 0 to n-1.
+func newBenchList(n int) *list.List {
+	l := list.New()
+	for i := 0; i < n; i++ {
+		l.PushBack(i)
+	}
+	return l
+}
+
+func BenchmarkQueueEnqueueDequeue(b *testing.B) {
+	testkit.Bench(b, "Queue", func(b *testing.B, n int) {
+		q := newBenchQueue(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.Enqueue(i)
+			benchSink, _ = q.Dequeue()
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchQueue(n).Values()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = append(s, i)
+			benchSink = s[0]
+			s = s[1:]
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			l.PushBack(i)
+			benchSink = l.Remove(l.Front())
+		}
+	})
+}
+
+func BenchmarkQueueEnqueueAllDequeueN(b *testing.B) {
+	batch := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	testkit.Bench(b, "Queue", func(b *testing.B, n int) {
+		q := newBenchQueue(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.EnqueueAll(batch...)
+			benchSink = q.DequeueN(len(batch))
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchQueue(n).Values()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s = append(s, batch...)
+			benchSink = slices.Clone(s[:len(batch)])
+			s = s[len(batch):]
+		}
+	})
+}
+
+func BenchmarkQueuePeek(b *testing.B) {
+	testkit.Bench(b, "Queue", func(b *testing.B, n int) {
+		q := newBenchQueue(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink, _ = q.Peek(i % n)
+		}
+	})
+	testkit.Bench(b, "slice", func(b *testing.B, n int) {
+		s := newBenchQueue(n).Values()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s[i%n]
+		}
+	})
+}
+
+func BenchmarkQueueValues(b *testing.B) {
+	testkit.Bench(b, "Queue", func(b *testing.B, n int) {
+		q := newBenchQueue(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = q.Values()
+		}
+	})
+	testkit.Bench(b, "list", func(b *testing.B, n int) {
+		l := newBenchList(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			values := make([]int, 0, l.Len())
+			for e := l.Front(); e != nil; e = e.Next() {
+				values = append(values, e.Value.(int))
+			}
+			benchSink = values
+		}
+	})
+}
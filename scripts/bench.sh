@@ -0,0 +1,36 @@
+#!/bin/sh
+# bench.sh runs the benchmarks of every package and writes the results in the format benchstat reads.
+#
+# Usage: scripts/bench.sh [output file] [baseline file]
+#
+# The results are written to the output file (bench.txt by default). If benchstat is installed,
+# it prints a summary that compares the implementations side by side, or, given a baseline file
+# from an earlier run, compares the new results against it.
+#
+# Environment variables:
+#   BENCH      the benchmarks to run, as a regular expression (default: .)
+#              e.g. BENCH='Queue//size=(10|1000)$' to run the Queue benchmarks at two sizes
+#   COUNT      the number of times to run each benchmark (default: 6)
+#   BENCHTIME  the minimum time or iterations of each run (default: 1s)
+#   PACKAGES   the packages to benchmark (default: ./...)
+set -eu
+
+cd "$(dirname "$0")/.."
+
+out=${1:-bench.txt}
+baseline=${2:-}
+
+go test -run '^$' -bench "${BENCH:-.}" -benchmem \
+	-count "${COUNT:-6}" -benchtime "${BENCHTIME:-1s}" ${PACKAGES:-./...} | tee "$out"
+
+if ! command -v benchstat >/dev/null 2>&1; then
+	echo "benchstat not found; install it with: go install golang.org/x/perf/cmd/benchstat@latest" >&2
+	exit 0
+fi
+
+echo
+if [ -n "$baseline" ]; then
+	benchstat "$baseline" "$out"
+else
+	benchstat -col /impl "$out"
+fi
@@ -0,0 +1,278 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"goCollections/collections"
+)
+
+const wordSize = 64
+
+// BitSet represents a set of non-negative integers stored as a bit vector.
+// It uses one bit per integer up to the largest element, which makes it far smaller
+// and faster than Set for dense ranges of integers.
+type BitSet struct {
+	words []uint64 // Bit i of words[i/64] is set when i is in the set.
+}
+
+var _ collections.SetLike[int] = (*BitSet)(nil)
+
+// NewBitSet creates and returns a new empty BitSet.
+func NewBitSet() *BitSet {
+	return &BitSet{}
+}
+
+// NewBitSetWithCapacity creates a new empty BitSet with room for the integers 0 to n-1
+// without reallocating.
+func NewBitSetWithCapacity(n int) *BitSet {
+	if n < 0 {
+		n = 0
+	}
+	return &BitSet{make([]uint64, 0, (n+wordSize-1)/wordSize)}
+}
+
+// NewBitSetFromSlice creates a new BitSet containing the integers in slice.
+// It panics if any integer is negative.
+func NewBitSetFromSlice(slice []int) *BitSet {
+	b := NewBitSet()
+	for _, i := range slice {
+		b.Add(i)
+	}
+	return b
+}
+
+// NewBitSetFromSet creates a new BitSet containing the elements of s.
+// It returns an error if s contains a negative integer.
+func NewBitSetFromSet(s *Set[int]) (*BitSet, error) {
+	b := NewBitSet()
+	for i := range s.elements {
+		if i < 0 {
+			return nil, errors.New("set contains a negative integer")
+		}
+		b.Add(i)
+	}
+	return b, nil
+}
+
+// checkBit panics if i cannot be stored in a bit set.
+func checkBit(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("set: negative bit set element %d", i))
+	}
+}
+
+// Add adds the integer i to the set.
+// It panics if i is negative.
+func (b *BitSet) Add(i int) {
+	checkBit(i)
+	w := i / wordSize
+	if w >= len(b.words) {
+		if w < cap(b.words) {
+			n := len(b.words)
+			b.words = b.words[:w+1]
+			// Words past the old length may hold bits left over from Clear or Remove.
+			for j := n; j <= w; j++ {
+				b.words[j] = 0
+			}
+		} else {
+			words := make([]uint64, w+1, 2*(w+1))
+			copy(words, b.words)
+			b.words = words
+		}
+	}
+	b.words[w] |= 1 << uint(i%wordSize)
+}
+
+// Remove removes the integer i from the set.
+func (b *BitSet) Remove(i int) {
+	if i < 0 || i/wordSize >= len(b.words) {
+		return
+	}
+	b.words[i/wordSize] &^= 1 << uint(i%wordSize)
+	b.trim()
+}
+
+// Contains checks if the set contains the integer i.
+// It returns true if i is in the set, otherwise it returns false.
+func (b *BitSet) Contains(i int) bool {
+	if i < 0 || i/wordSize >= len(b.words) {
+		return false
+	}
+	return b.words[i/wordSize]&(1<<uint(i%wordSize)) != 0
+}
+
+// Len returns the number of integers in the set.
+func (b *BitSet) Len() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// IsEmpty checks if the set is empty.
+func (b *BitSet) IsEmpty() bool {
+	return len(b.words) == 0
+}
+
+// Clear removes all integers from the set.
+func (b *BitSet) Clear() {
+	b.words = b.words[:0]
+}
+
+// trim drops trailing zero words so that the length of words always ends at
+// the word holding the largest element.
+func (b *BitSet) trim() {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+	b.words = b.words[:n]
+}
+
+// NextSet returns the smallest integer in the set that is greater than or equal to i.
+// The second result is false if there is no such integer.
+func (b *BitSet) NextSet(i int) (int, bool) {
+	if i < 0 {
+		i = 0
+	}
+	w := i / wordSize
+	if w >= len(b.words) {
+		return 0, false
+	}
+	word := b.words[w] >> uint(i%wordSize)
+	if word != 0 {
+		return i + bits.TrailingZeros64(word), true
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + bits.TrailingZeros64(b.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// Values returns a slice containing the elements of the bit set. It is the same as ToSlice.
+func (b *BitSet) Values() []int {
+	return b.ToSlice()
+}
+
+// ToSlice returns the integers in the set in ascending order.
+func (b *BitSet) ToSlice() []int {
+	slice := make([]int, 0, b.Len())
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		slice = append(slice, i)
+	}
+	return slice
+}
+
+// ToSet returns a new Set containing the integers in the bit set.
+func (b *BitSet) ToSet() *Set[int] {
+	s := NewSet[int]()
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		s.Add(i)
+	}
+	return s
+}
+
+// Equal checks if the current set contains exactly the same integers as the other set.
+func (b *BitSet) Equal(other *BitSet) bool {
+	if len(b.words) != len(other.words) {
+		return false
+	}
+	for i, w := range b.words {
+		if w != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone creates a new BitSet that is a copy of the current set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{append([]uint64(nil), b.words...)}
+}
+
+// String returns a string representation of the set, with the integers in ascending order.
+func (b *BitSet) String() string {
+	return fmt.Sprintf("%v", b.ToSlice())
+}
+
+// setOp describes a binary set operation in terms of which integers it keeps.
+type setOp struct {
+	left  bool                     // Keep integers only in the left operand.
+	right bool                     // Keep integers only in the right operand.
+	both  bool                     // Keep integers in both operands.
+	word  func(x, y uint64) uint64 // The operation applied to 64 integers at a time.
+}
+
+var (
+	unionOp               = setOp{true, true, true, func(x, y uint64) uint64 { return x | y }}
+	intersectionOp        = setOp{false, false, true, func(x, y uint64) uint64 { return x & y }}
+	differenceOp          = setOp{true, false, false, func(x, y uint64) uint64 { return x &^ y }}
+	symmetricDifferenceOp = setOp{true, true, false, func(x, y uint64) uint64 { return x ^ y }}
+)
+
+// combine returns a new BitSet whose words are op applied to the words of b and other.
+// Words missing from the shorter set are treated as zero.
+func (b *BitSet) combine(other *BitSet, op setOp) *BitSet {
+	n := len(b.words)
+	if len(other.words) > n {
+		n = len(other.words)
+	}
+	result := &BitSet{make([]uint64, n)}
+	for i := range result.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op.word(x, y)
+	}
+	result.trim()
+	return result
+}
+
+// Union returns a new set containing the integers in either the current set or the other set.
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	return b.combine(other, unionOp)
+}
+
+// Intersection returns a new set containing the integers in both the current set and the other set.
+func (b *BitSet) Intersection(other *BitSet) *BitSet {
+	return b.combine(other, intersectionOp)
+}
+
+// Difference returns a new set containing the integers in the current set but not in the other set.
+func (b *BitSet) Difference(other *BitSet) *BitSet {
+	return b.combine(other, differenceOp)
+}
+
+// SymmetricDifference returns a new set containing the integers in either the current set
+// or the other set, but not in both.
+func (b *BitSet) SymmetricDifference(other *BitSet) *BitSet {
+	return b.combine(other, symmetricDifferenceOp)
+}
+
+// IsSubset checks if every integer in the current set is also in the other set.
+func (b *BitSet) IsSubset(other *BitSet) bool {
+	for i, w := range b.words {
+		if i >= len(other.words) || w&^other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Compress returns a CompressedBitSet containing the integers in the set.
+func (b *BitSet) Compress() *CompressedBitSet {
+	c := NewCompressedBitSet()
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		c.Add(i)
+	}
+	return c
+}
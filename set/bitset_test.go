@@ -0,0 +1,165 @@
+package set
+
+import (
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestBitSetAddRemoveContains(t *testing.T) {
+	// Create a new instance of the BitSet struct
+	b := NewBitSet()
+
+	// Add some integers to the set
+	b.Add(1)
+	b.Add(64)
+	b.Add(1000)
+	b.Add(64)
+
+	// Test Contains
+	if !b.Contains(1) || !b.Contains(64) || !b.Contains(1000) {
+		t.Errorf("Expected set to contain 1, 64 and 1000")
+	}
+	if b.Contains(2) || b.Contains(-1) || b.Contains(5000) {
+		t.Errorf("Expected set not to contain 2, -1 or 5000")
+	}
+
+	// Test Len
+	if b.Len() != 3 {
+		t.Errorf("Expected set length to be 3, got %d", b.Len())
+	}
+
+	// Test Remove
+	b.Remove(1000)
+	if b.Contains(1000) {
+		t.Errorf("Expected set not to contain 1000")
+	}
+	if b.Len() != 2 {
+		t.Errorf("Expected set length to be 2, got %d", b.Len())
+	}
+
+	// Test Clear and reuse of the storage
+	b.Clear()
+	if !b.IsEmpty() {
+		t.Errorf("Expected set to be empty")
+	}
+	b.Add(100)
+	if b.Contains(64) {
+		t.Errorf("Expected set not to contain 64 after Clear")
+	}
+}
+
+func TestBitSetAddNegative(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected Add of a negative integer to panic")
+		}
+	}()
+	NewBitSet().Add(-1)
+}
+
+func TestBitSetNextSet(t *testing.T) {
+	// Create a new BitSet
+	b := NewBitSetFromSlice([]int{3, 70, 200})
+
+	// Test NextSet
+	if i, ok := b.NextSet(0); !ok || i != 3 {
+		t.Errorf("Expected NextSet(0) to be 3, got %d", i)
+	}
+	if i, ok := b.NextSet(4); !ok || i != 70 {
+		t.Errorf("Expected NextSet(4) to be 70, got %d", i)
+	}
+	if i, ok := b.NextSet(200); !ok || i != 200 {
+		t.Errorf("Expected NextSet(200) to be 200, got %d", i)
+	}
+	if _, ok := b.NextSet(201); ok {
+		t.Errorf("Expected NextSet(201) to find nothing")
+	}
+
+	// Test ToSlice ordering
+	expected := []int{3, 70, 200}
+	for i, v := range b.ToSlice() {
+		if v != expected[i] {
+			t.Errorf("Expected element %d to be %d, got %d", i, expected[i], v)
+		}
+	}
+}
+
+func TestBitSetOperations(t *testing.T) {
+	// Create two BitSets
+	b1 := NewBitSetFromSlice([]int{1, 2, 3, 100})
+	b2 := NewBitSetFromSlice([]int{3, 4, 100, 200})
+
+	// Test Union
+	if !b1.Union(b2).Equal(NewBitSetFromSlice([]int{1, 2, 3, 4, 100, 200})) {
+		t.Errorf("Unexpected union %v", b1.Union(b2))
+	}
+
+	// Test Intersection
+	if !b1.Intersection(b2).Equal(NewBitSetFromSlice([]int{3, 100})) {
+		t.Errorf("Unexpected intersection %v", b1.Intersection(b2))
+	}
+
+	// Test Difference
+	if !b1.Difference(b2).Equal(NewBitSetFromSlice([]int{1, 2})) {
+		t.Errorf("Unexpected difference %v", b1.Difference(b2))
+	}
+
+	// Test SymmetricDifference
+	if !b2.SymmetricDifference(b1).Equal(NewBitSetFromSlice([]int{1, 2, 4, 200})) {
+		t.Errorf("Unexpected symmetric difference %v", b2.SymmetricDifference(b1))
+	}
+
+	// Test that the difference trims empty words
+	if !b1.Difference(b1).IsEmpty() {
+		t.Errorf("Expected difference with itself to be empty")
+	}
+
+	// Test IsSubset
+	if !NewBitSetFromSlice([]int{3, 100}).IsSubset(b1) {
+		t.Errorf("Expected {3, 100} to be a subset")
+	}
+	if b2.IsSubset(b1) {
+		t.Errorf("Expected b2 not to be a subset of b1")
+	}
+}
+
+func TestBitSetSetConversion(t *testing.T) {
+	// Create a new Set
+	s := NewSetFromSlice([]int{1, 5, 9})
+
+	// Test NewBitSetFromSet
+	b, err := NewBitSetFromSet(s)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if b.Len() != 3 || !b.Contains(5) {
+		t.Errorf("Unexpected bit set %v", b)
+	}
+
+	// Test ToSet
+	if !b.ToSet().Equal(s) {
+		t.Errorf("Expected ToSet to equal the original set")
+	}
+
+	// Test conversion of an invalid set
+	if _, err := NewBitSetFromSet(NewSetFromSlice([]int{-1})); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestBitSetConformance(t *testing.T) {
+	// Test that BitSet behaves like every other SetLike
+	testkit.TestSet(t, func() collections.SetLike[int] { return NewBitSet() },
+		func(i int) int { return i * 100 },
+		testkit.WithClone(func(c collections.Collection[int]) collections.Collection[int] { return c.(*BitSet).Clone() }))
+}
+
+func FuzzBitSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 70, 3, 70, 3, 127, 4, 0, 5, 0, 6, 0, 7, 0, 1, 1, 2, 1})
+	f.Add([]byte{8, 0, 3, 10, 9, 5, 4, 0, 7, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, data, NewBitSet, func(arg int) int { return arg + 128 })
+	})
+}
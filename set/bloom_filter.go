@@ -0,0 +1,167 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// BloomFilter represents a probabilistic set that answers membership queries in bounded memory.
+// Contains never returns false for an item that was added, but may return true for an item
+// that was not, with a probability that depends on how full the filter is.
+type BloomFilter struct {
+	words []uint64 // The bit array, m bits long.
+	m     uint64   // The number of bits in the filter.
+	k     uint64   // The number of hash functions.
+}
+
+// NewBloomFilter creates a new BloomFilter sized to hold n items with a false positive rate of at most p.
+// It returns an error if n is not positive or p is not between 0 and 1.
+func NewBloomFilter(n int, p float64) (*BloomFilter, error) {
+	if n <= 0 || p <= 0 || p >= 1 {
+		return nil, errors.New("invalid bloom filter parameters")
+	}
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(n)*math.Ln2))
+	return NewBloomFilterWithSize(uint64(m), uint64(k))
+}
+
+// NewBloomFilterWithSize creates a new BloomFilter with m bits and k hash functions.
+// It returns an error if m or k is zero.
+func NewBloomFilterWithSize(m, k uint64) (*BloomFilter, error) {
+	if m == 0 || k == 0 {
+		return nil, errors.New("invalid bloom filter parameters")
+	}
+	return &BloomFilter{
+		words: make([]uint64, (m+wordSize-1)/wordSize),
+		m:     m,
+		k:     k,
+	}, nil
+}
+
+// hashItem returns a 64-bit hash of item.
+func hashItem(item []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(item)
+	return h.Sum64()
+}
+
+// bloomHashes splits the hash of item into the two hashes used for double hashing.
+// The second hash is odd so that successive probes never collapse onto one bit.
+func bloomHashes(item []byte) (uint64, uint64) {
+	sum := hashItem(item)
+	return sum & math.MaxUint32, sum>>32 | 1
+}
+
+// Add adds an item to the filter.
+func (f *BloomFilter) Add(item []byte) {
+	h1, h2 := bloomHashes(item)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.words[bit/wordSize] |= 1 << (bit % wordSize)
+	}
+}
+
+// AddString adds a string item to the filter.
+func (f *BloomFilter) AddString(item string) {
+	f.Add([]byte(item))
+}
+
+// Contains checks if the item may have been added to the filter.
+// It returns false only if the item was definitely never added.
+func (f *BloomFilter) Contains(item []byte) bool {
+	h1, h2 := bloomHashes(item)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.words[bit/wordSize]&(1<<(bit%wordSize)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsString checks if the string item may have been added to the filter.
+func (f *BloomFilter) ContainsString(item string) bool {
+	return f.Contains([]byte(item))
+}
+
+// Size returns the number of bits in the filter.
+func (f *BloomFilter) Size() uint64 {
+	return f.m
+}
+
+// HashCount returns the number of hash functions used by the filter.
+func (f *BloomFilter) HashCount() uint64 {
+	return f.k
+}
+
+// FillRatio returns the fraction of bits in the filter that are set.
+func (f *BloomFilter) FillRatio() float64 {
+	set := 0
+	for _, w := range f.words {
+		set += bits.OnesCount64(w)
+	}
+	return float64(set) / float64(f.m)
+}
+
+// EstimatedCount returns an estimate of the number of distinct items added to the filter.
+func (f *BloomFilter) EstimatedCount() int {
+	ratio := f.FillRatio()
+	if ratio >= 1 {
+		return math.MaxInt
+	}
+	return int(math.Round(-float64(f.m) / float64(f.k) * math.Log(1-ratio)))
+}
+
+// Clear removes all items from the filter.
+func (f *BloomFilter) Clear() {
+	for i := range f.words {
+		f.words[i] = 0
+	}
+}
+
+// Union returns a new filter containing the items of both the current filter and the other filter.
+// It returns an error if the filters do not have the same size and number of hash functions.
+func (f *BloomFilter) Union(other *BloomFilter) (*BloomFilter, error) {
+	if f.m != other.m || f.k != other.k {
+		return nil, errors.New("incompatible filters")
+	}
+	union, _ := NewBloomFilterWithSize(f.m, f.k)
+	for i := range union.words {
+		union.words[i] = f.words[i] | other.words[i]
+	}
+	return union, nil
+}
+
+// MarshalBinary encodes the filter into a binary form.
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 16+8*len(f.words))
+	binary.BigEndian.PutUint64(data[0:], f.m)
+	binary.BigEndian.PutUint64(data[8:], f.k)
+	for i, w := range f.words {
+		binary.BigEndian.PutUint64(data[16+8*i:], w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the filter from the binary form produced by MarshalBinary.
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 16 {
+		return errors.New("invalid bloom filter encoding")
+	}
+	m := binary.BigEndian.Uint64(data[0:])
+	k := binary.BigEndian.Uint64(data[8:])
+	// The sizes are derived from the length of data, so that a crafted m cannot overflow the check.
+	n := uint64(len(data)-16) / 8
+	if m == 0 || k == 0 || (len(data)-16)%8 != 0 || m > n*wordSize || m <= (n-1)*wordSize {
+		return errors.New("invalid bloom filter encoding")
+	}
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = binary.BigEndian.Uint64(data[16+8*i:])
+	}
+	f.words, f.m, f.k = words, m, k
+	return nil
+}
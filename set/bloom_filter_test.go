@@ -0,0 +1,150 @@
+package set
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNewBloomFilter(t *testing.T) {
+	// Test sizing from n and p
+	f, err := NewBloomFilter(1000, 0.01)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if f.Size() != 9586 {
+		t.Errorf("Expected filter size to be 9586, got %d", f.Size())
+	}
+	if f.HashCount() != 7 {
+		t.Errorf("Expected hash count to be 7, got %d", f.HashCount())
+	}
+
+	// Test invalid parameters
+	if _, err := NewBloomFilter(0, 0.01); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+	if _, err := NewBloomFilter(10, 1); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestBloomFilterAddContains(t *testing.T) {
+	// Create a new filter and add some items
+	f, _ := NewBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.AddString(fmt.Sprintf("item-%d", i))
+	}
+
+	// Test that every added item is found
+	for i := 0; i < 1000; i++ {
+		if !f.ContainsString(fmt.Sprintf("item-%d", i)) {
+			t.Errorf("Expected filter to contain item-%d", i)
+		}
+	}
+
+	// Test that the false positive rate is close to the target
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if f.ContainsString(fmt.Sprintf("other-%d", i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 200 {
+		t.Errorf("Expected at most 200 false positives, got %d", falsePositives)
+	}
+
+	// Test FillRatio and EstimatedCount
+	if ratio := f.FillRatio(); ratio < 0.4 || ratio > 0.6 {
+		t.Errorf("Expected fill ratio near 0.5, got %v", ratio)
+	}
+	if count := f.EstimatedCount(); count < 950 || count > 1050 {
+		t.Errorf("Expected estimated count near 1000, got %d", count)
+	}
+
+	// Test Clear
+	f.Clear()
+	if f.ContainsString("item-0") {
+		t.Errorf("Expected filter not to contain item-0 after Clear")
+	}
+}
+
+func TestBloomFilterUnion(t *testing.T) {
+	// Create two compatible filters
+	f1, _ := NewBloomFilter(100, 0.01)
+	f2, _ := NewBloomFilter(100, 0.01)
+	f1.AddString("a")
+	f2.AddString("b")
+
+	// Test Union
+	union, err := f1.Union(f2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !union.ContainsString("a") || !union.ContainsString("b") {
+		t.Errorf("Expected union to contain a and b")
+	}
+
+	// Test Union of incompatible filters
+	f3, _ := NewBloomFilter(200, 0.01)
+	if _, err := f1.Union(f3); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestBloomFilterBinary(t *testing.T) {
+	// Create a new filter and add an item
+	f, _ := NewBloomFilter(100, 0.01)
+	f.AddString("a")
+
+	// Test round trip
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	decoded := &BloomFilter{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !decoded.ContainsString("a") || decoded.Size() != f.Size() || decoded.HashCount() != f.HashCount() {
+		t.Errorf("Expected decoded filter to match the original")
+	}
+
+	// Test truncated data
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test headers whose sizes do not match the data, including sizes that overflow when multiplied
+	for _, m := range []uint64{math.MaxUint64, math.MaxUint64 - 62, 1 << 63, 65, 129} {
+		header := binary.BigEndian.AppendUint64(nil, m)
+		header = binary.BigEndian.AppendUint64(header, 1)
+		for _, words := range []int{0, 1} {
+			if err := decoded.UnmarshalBinary(append(header, make([]byte, 8*words)...)); err == nil {
+				t.Errorf("Expected an error for m = %d with %d words, got nil", m, words)
+			}
+		}
+	}
+}
+
+func FuzzBloomFilterUnmarshal(f *testing.F) {
+	valid, _ := NewBloomFilterWithSize(100, 3)
+	valid.AddString("a")
+	data, _ := valid.MarshalBinary()
+	f.Add(data)
+	f.Add(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, math.MaxUint64), 1))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Decoding any data must either fail or give a filter that can be used and encoded again
+		decoded := &BloomFilter{}
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+		decoded.AddString("a")
+		if !decoded.ContainsString("a") {
+			t.Fatalf("Expected the decoded filter to contain an added item")
+		}
+		if encoded, _ := decoded.MarshalBinary(); len(encoded) != len(data) {
+			t.Fatalf("Expected %d bytes when encoding again, got %d", len(data), len(encoded))
+		}
+	})
+}
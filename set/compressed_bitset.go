@@ -0,0 +1,461 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+
+	"goCollections/collections"
+)
+
+const (
+	// containerBits is the number of low bits of an integer stored inside a container.
+	containerBits = 16
+	// containerWords is the number of words in a bitmap container.
+	containerWords = (1 << containerBits) / wordSize
+	// maxArrayContainer is the largest number of integers kept in an array container
+	// before it is converted to a bitmap container.
+	maxArrayContainer = 4096
+)
+
+// container holds the integers of a CompressedBitSet that share the same high bits.
+// Sparse containers store their low bits as a sorted array, dense containers as a bitmap.
+type container struct {
+	key    int      // The high bits shared by every integer in the container.
+	array  []uint16 // The sorted low bits, used while bitmap is nil.
+	bitmap []uint64 // The low bits as a bit vector, used once the container is dense.
+	card   int      // The number of integers in the container.
+}
+
+// CompressedBitSet represents a set of non-negative integers using roaring-style compression.
+// Integers are grouped by their high bits into containers that switch between a sorted array
+// and a bitmap depending on how many integers they hold, so sparse sets use far less memory
+// than a BitSet while dense regions keep word-parallel operations.
+type CompressedBitSet struct {
+	containers []*container // Sorted by key.
+}
+
+var _ collections.SetLike[int] = (*CompressedBitSet)(nil)
+
+// NewCompressedBitSet creates and returns a new empty CompressedBitSet.
+func NewCompressedBitSet() *CompressedBitSet {
+	return &CompressedBitSet{}
+}
+
+// NewCompressedBitSetFromSlice creates a new CompressedBitSet containing the integers in slice.
+// It panics if any integer is negative.
+func NewCompressedBitSetFromSlice(slice []int) *CompressedBitSet {
+	c := NewCompressedBitSet()
+	for _, i := range slice {
+		c.Add(i)
+	}
+	return c
+}
+
+// NewCompressedBitSetFromSet creates a new CompressedBitSet containing the elements of s.
+// It returns an error if s contains a negative integer.
+func NewCompressedBitSetFromSet(s *Set[int]) (*CompressedBitSet, error) {
+	c := NewCompressedBitSet()
+	for i := range s.elements {
+		if i < 0 {
+			return nil, errors.New("set contains a negative integer")
+		}
+		c.Add(i)
+	}
+	return c, nil
+}
+
+// split returns the container key and low bits of the integer i.
+func split(i int) (int, uint16) {
+	return i >> containerBits, uint16(i)
+}
+
+// find returns the position of the container with the given key,
+// or the position where it would be inserted, and whether it exists.
+func (c *CompressedBitSet) find(key int) (int, bool) {
+	pos := sort.Search(len(c.containers), func(i int) bool {
+		return c.containers[i].key >= key
+	})
+	return pos, pos < len(c.containers) && c.containers[pos].key == key
+}
+
+// Add adds the integer i to the set.
+// It panics if i is negative.
+func (c *CompressedBitSet) Add(i int) {
+	checkBit(i)
+	key, low := split(i)
+	pos, ok := c.find(key)
+	if !ok {
+		c.containers = append(c.containers, nil)
+		copy(c.containers[pos+1:], c.containers[pos:])
+		c.containers[pos] = &container{key: key}
+	}
+	c.containers[pos].add(low)
+}
+
+// Remove removes the integer i from the set.
+func (c *CompressedBitSet) Remove(i int) {
+	if i < 0 {
+		return
+	}
+	key, low := split(i)
+	pos, ok := c.find(key)
+	if !ok {
+		return
+	}
+	ct := c.containers[pos]
+	ct.remove(low)
+	if ct.card == 0 {
+		c.containers = append(c.containers[:pos], c.containers[pos+1:]...)
+	}
+}
+
+// Contains checks if the set contains the integer i.
+// It returns true if i is in the set, otherwise it returns false.
+func (c *CompressedBitSet) Contains(i int) bool {
+	if i < 0 {
+		return false
+	}
+	key, low := split(i)
+	pos, ok := c.find(key)
+	return ok && c.containers[pos].contains(low)
+}
+
+// Len returns the number of integers in the set.
+func (c *CompressedBitSet) Len() int {
+	n := 0
+	for _, ct := range c.containers {
+		n += ct.card
+	}
+	return n
+}
+
+// IsEmpty checks if the set is empty.
+func (c *CompressedBitSet) IsEmpty() bool {
+	return len(c.containers) == 0
+}
+
+// Clear removes all integers from the set.
+func (c *CompressedBitSet) Clear() {
+	c.containers = nil
+}
+
+// NextSet returns the smallest integer in the set that is greater than or equal to i.
+// The second result is false if there is no such integer.
+func (c *CompressedBitSet) NextSet(i int) (int, bool) {
+	if i < 0 {
+		i = 0
+	}
+	key, low := split(i)
+	pos, ok := c.find(key)
+	if ok {
+		if next, found := c.containers[pos].next(int(low)); found {
+			return key<<containerBits | next, true
+		}
+		pos++
+	}
+	if pos < len(c.containers) {
+		ct := c.containers[pos]
+		next, _ := ct.next(0)
+		return ct.key<<containerBits | next, true
+	}
+	return 0, false
+}
+
+// Values returns a slice containing the elements of the bit set. It is the same as ToSlice.
+func (c *CompressedBitSet) Values() []int {
+	return c.ToSlice()
+}
+
+// ToSlice returns the integers in the set in ascending order.
+func (c *CompressedBitSet) ToSlice() []int {
+	slice := make([]int, 0, c.Len())
+	for _, ct := range c.containers {
+		ct.each(func(low int) {
+			slice = append(slice, ct.key<<containerBits|low)
+		})
+	}
+	return slice
+}
+
+// ToSet returns a new Set containing the integers in the compressed bit set.
+func (c *CompressedBitSet) ToSet() *Set[int] {
+	s := NewSet[int]()
+	for _, i := range c.ToSlice() {
+		s.Add(i)
+	}
+	return s
+}
+
+// ToBitSet returns an uncompressed BitSet containing the integers in the set.
+func (c *CompressedBitSet) ToBitSet() *BitSet {
+	b := NewBitSet()
+	for _, ct := range c.containers {
+		ct.each(func(low int) {
+			b.Add(ct.key<<containerBits | low)
+		})
+	}
+	return b
+}
+
+// Equal checks if the current set contains exactly the same integers as the other set.
+func (c *CompressedBitSet) Equal(other *CompressedBitSet) bool {
+	if len(c.containers) != len(other.containers) {
+		return false
+	}
+	for i, ct := range c.containers {
+		o := other.containers[i]
+		if ct.key != o.key || ct.card != o.card {
+			return false
+		}
+		equal := true
+		ct.each(func(low int) {
+			if !o.contains(uint16(low)) {
+				equal = false
+			}
+		})
+		if !equal {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone creates a new CompressedBitSet that is a copy of the current set.
+func (c *CompressedBitSet) Clone() *CompressedBitSet {
+	clone := &CompressedBitSet{make([]*container, len(c.containers))}
+	for i, ct := range c.containers {
+		clone.containers[i] = ct.clone()
+	}
+	return clone
+}
+
+// String returns a string representation of the set, with the integers in ascending order.
+func (c *CompressedBitSet) String() string {
+	return fmt.Sprintf("%v", c.ToSlice())
+}
+
+// combine returns a new set built container by container from c and other according to op.
+func (c *CompressedBitSet) combine(other *CompressedBitSet, op setOp) *CompressedBitSet {
+	result := NewCompressedBitSet()
+	i, j := 0, 0
+	for i < len(c.containers) || j < len(other.containers) {
+		switch {
+		case j == len(other.containers) || (i < len(c.containers) && c.containers[i].key < other.containers[j].key):
+			if op.left {
+				result.containers = append(result.containers, c.containers[i].clone())
+			}
+			i++
+		case i == len(c.containers) || other.containers[j].key < c.containers[i].key:
+			if op.right {
+				result.containers = append(result.containers, other.containers[j].clone())
+			}
+			j++
+		default:
+			if ct := combineContainers(c.containers[i], other.containers[j], op); ct.card > 0 {
+				result.containers = append(result.containers, ct)
+			}
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Union returns a new set containing the integers in either the current set or the other set.
+func (c *CompressedBitSet) Union(other *CompressedBitSet) *CompressedBitSet {
+	return c.combine(other, unionOp)
+}
+
+// Intersection returns a new set containing the integers in both the current set and the other set.
+func (c *CompressedBitSet) Intersection(other *CompressedBitSet) *CompressedBitSet {
+	return c.combine(other, intersectionOp)
+}
+
+// Difference returns a new set containing the integers in the current set but not in the other set.
+func (c *CompressedBitSet) Difference(other *CompressedBitSet) *CompressedBitSet {
+	return c.combine(other, differenceOp)
+}
+
+// SymmetricDifference returns a new set containing the integers in either the current set
+// or the other set, but not in both.
+func (c *CompressedBitSet) SymmetricDifference(other *CompressedBitSet) *CompressedBitSet {
+	return c.combine(other, symmetricDifferenceOp)
+}
+
+// combineContainers returns a new container holding the result of op applied to a and b.
+// Two array containers are merged directly, otherwise the bitmaps are combined a word at a time.
+func combineContainers(a, b *container, op setOp) *container {
+	result := &container{key: a.key}
+	if a.bitmap == nil && b.bitmap == nil {
+		result.array = mergeArrays(a.array, b.array, op)
+		result.card = len(result.array)
+		if result.card > maxArrayContainer {
+			result.bitmap = result.words()
+			result.array = nil
+		}
+		return result
+	}
+	x, y := a.words(), b.words()
+	result.bitmap = make([]uint64, containerWords)
+	for i := range result.bitmap {
+		result.bitmap[i] = op.word(x[i], y[i])
+		result.card += bits.OnesCount64(result.bitmap[i])
+	}
+	result.shrink()
+	return result
+}
+
+// mergeArrays merges the sorted arrays a and b, keeping the values selected by op.
+func mergeArrays(a, b []uint16, op setOp) []uint16 {
+	merged := make([]uint16, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || (i < len(a) && a[i] < b[j]):
+			if op.left {
+				merged = append(merged, a[i])
+			}
+			i++
+		case i == len(a) || b[j] < a[i]:
+			if op.right {
+				merged = append(merged, b[j])
+			}
+			j++
+		default:
+			if op.both {
+				merged = append(merged, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	return merged
+}
+
+// contains checks if the container holds the low bits low.
+func (ct *container) contains(low uint16) bool {
+	if ct.bitmap != nil {
+		return ct.bitmap[low/wordSize]&(1<<(low%wordSize)) != 0
+	}
+	pos := sort.Search(len(ct.array), func(i int) bool { return ct.array[i] >= low })
+	return pos < len(ct.array) && ct.array[pos] == low
+}
+
+// add adds the low bits low to the container, converting it to a bitmap when it becomes dense.
+func (ct *container) add(low uint16) {
+	if ct.bitmap != nil {
+		if ct.bitmap[low/wordSize]&(1<<(low%wordSize)) == 0 {
+			ct.bitmap[low/wordSize] |= 1 << (low % wordSize)
+			ct.card++
+		}
+		return
+	}
+	pos := sort.Search(len(ct.array), func(i int) bool { return ct.array[i] >= low })
+	if pos < len(ct.array) && ct.array[pos] == low {
+		return
+	}
+	ct.array = append(ct.array, 0)
+	copy(ct.array[pos+1:], ct.array[pos:])
+	ct.array[pos] = low
+	ct.card++
+	if ct.card > maxArrayContainer {
+		ct.bitmap = ct.words()
+		ct.array = nil
+	}
+}
+
+// remove removes the low bits low from the container, converting it to an array when it becomes sparse.
+func (ct *container) remove(low uint16) {
+	if ct.bitmap != nil {
+		if ct.bitmap[low/wordSize]&(1<<(low%wordSize)) != 0 {
+			ct.bitmap[low/wordSize] &^= 1 << (low % wordSize)
+			ct.card--
+			ct.shrink()
+		}
+		return
+	}
+	pos := sort.Search(len(ct.array), func(i int) bool { return ct.array[i] >= low })
+	if pos < len(ct.array) && ct.array[pos] == low {
+		ct.array = append(ct.array[:pos], ct.array[pos+1:]...)
+		ct.card--
+	}
+}
+
+// shrink converts a bitmap container that has become sparse back into an array container.
+func (ct *container) shrink() {
+	if ct.bitmap == nil || ct.card > maxArrayContainer {
+		return
+	}
+	array := make([]uint16, 0, ct.card)
+	ct.each(func(low int) {
+		array = append(array, uint16(low))
+	})
+	ct.array = array
+	ct.bitmap = nil
+}
+
+// next returns the smallest low bits in the container greater than or equal to low.
+func (ct *container) next(low int) (int, bool) {
+	if ct.bitmap != nil {
+		w := low / wordSize
+		if w >= containerWords {
+			return 0, false
+		}
+		word := ct.bitmap[w] >> uint(low%wordSize)
+		if word != 0 {
+			return low + bits.TrailingZeros64(word), true
+		}
+		for w++; w < containerWords; w++ {
+			if ct.bitmap[w] != 0 {
+				return w*wordSize + bits.TrailingZeros64(ct.bitmap[w]), true
+			}
+		}
+		return 0, false
+	}
+	pos := sort.Search(len(ct.array), func(i int) bool { return int(ct.array[i]) >= low })
+	if pos < len(ct.array) {
+		return int(ct.array[pos]), true
+	}
+	return 0, false
+}
+
+// each calls fn with the low bits of every integer in the container, in ascending order.
+func (ct *container) each(fn func(low int)) {
+	if ct.bitmap == nil {
+		for _, low := range ct.array {
+			fn(int(low))
+		}
+		return
+	}
+	for w, word := range ct.bitmap {
+		for word != 0 {
+			fn(w*wordSize + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+// words returns the contents of the container as a bitmap.
+// The result must not be modified if the container is already a bitmap.
+func (ct *container) words() []uint64 {
+	if ct.bitmap != nil {
+		return ct.bitmap
+	}
+	bitmap := make([]uint64, containerWords)
+	for _, low := range ct.array {
+		bitmap[low/wordSize] |= 1 << (low % wordSize)
+	}
+	return bitmap
+}
+
+// clone returns a copy of the container.
+func (ct *container) clone() *container {
+	return &container{
+		key:    ct.key,
+		array:  append([]uint16(nil), ct.array...),
+		bitmap: append([]uint64(nil), ct.bitmap...),
+		card:   ct.card,
+	}
+}
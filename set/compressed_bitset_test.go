@@ -0,0 +1,161 @@
+package set
+
+import (
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestCompressedBitSetAddRemoveContains(t *testing.T) {
+	// Create a new instance of the CompressedBitSet struct
+	c := NewCompressedBitSet()
+
+	// Add integers spread over several containers
+	c.Add(5)
+	c.Add(70000)
+	c.Add(1 << 30)
+	c.Add(5)
+
+	// Test Contains and Len
+	if !c.Contains(5) || !c.Contains(70000) || !c.Contains(1<<30) {
+		t.Errorf("Expected set to contain 5, 70000 and 2^30")
+	}
+	if c.Contains(6) || c.Contains(-5) {
+		t.Errorf("Expected set not to contain 6 or -5")
+	}
+	if c.Len() != 3 {
+		t.Errorf("Expected set length to be 3, got %d", c.Len())
+	}
+
+	// Test Remove drops empty containers
+	c.Remove(70000)
+	c.Remove(5)
+	c.Remove(1 << 30)
+	if !c.IsEmpty() {
+		t.Errorf("Expected set to be empty, got %v", c)
+	}
+}
+
+func TestCompressedBitSetDenseContainer(t *testing.T) {
+	// Fill one container past the array threshold
+	c := NewCompressedBitSet()
+	for i := 0; i < 10000; i++ {
+		c.Add(i * 2)
+	}
+	if c.containers[0].bitmap == nil {
+		t.Errorf("Expected the first container to be a bitmap")
+	}
+	if c.Len() != 10000 || !c.Contains(19998) || c.Contains(19999) {
+		t.Errorf("Unexpected dense set contents")
+	}
+
+	// Remove until the container is sparse again
+	for i := 0; i < 8000; i++ {
+		c.Remove(i * 2)
+	}
+	if c.containers[0].bitmap != nil {
+		t.Errorf("Expected the first container to be an array")
+	}
+	if c.Len() != 2000 || !c.Contains(16000) || c.Contains(15998) {
+		t.Errorf("Unexpected sparse set contents")
+	}
+}
+
+func TestCompressedBitSetNextSet(t *testing.T) {
+	// Create a new CompressedBitSet
+	c := NewCompressedBitSetFromSlice([]int{10, 65536, 200000})
+
+	// Test NextSet within and across containers
+	if i, ok := c.NextSet(0); !ok || i != 10 {
+		t.Errorf("Expected NextSet(0) to be 10, got %d", i)
+	}
+	if i, ok := c.NextSet(11); !ok || i != 65536 {
+		t.Errorf("Expected NextSet(11) to be 65536, got %d", i)
+	}
+	if i, ok := c.NextSet(65537); !ok || i != 200000 {
+		t.Errorf("Expected NextSet(65537) to be 200000, got %d", i)
+	}
+	if _, ok := c.NextSet(200001); ok {
+		t.Errorf("Expected NextSet(200001) to find nothing")
+	}
+}
+
+func TestCompressedBitSetOperations(t *testing.T) {
+	// Create a dense and a sparse set that overlap
+	dense := NewCompressedBitSet()
+	for i := 0; i < 5000; i++ {
+		dense.Add(i)
+	}
+	dense.Add(100000)
+	sparse := NewCompressedBitSetFromSlice([]int{4999, 5000, 100000, 300000})
+
+	// Compare every operation with the uncompressed BitSet
+	db, sb := dense.ToBitSet(), sparse.ToBitSet()
+	if !dense.Union(sparse).ToBitSet().Equal(db.Union(sb)) {
+		t.Errorf("Unexpected union")
+	}
+	if !dense.Intersection(sparse).ToBitSet().Equal(db.Intersection(sb)) {
+		t.Errorf("Unexpected intersection %v", dense.Intersection(sparse))
+	}
+	if !dense.Difference(sparse).ToBitSet().Equal(db.Difference(sb)) {
+		t.Errorf("Unexpected difference")
+	}
+	if !sparse.SymmetricDifference(dense).ToBitSet().Equal(sb.SymmetricDifference(db)) {
+		t.Errorf("Unexpected symmetric difference")
+	}
+	if !sparse.Union(sparse).Equal(sparse) {
+		t.Errorf("Expected union with itself to equal the set")
+	}
+	if !sparse.Difference(sparse).IsEmpty() {
+		t.Errorf("Expected difference with itself to be empty")
+	}
+}
+
+func TestCompressedBitSetConversion(t *testing.T) {
+	// Create a new Set
+	s := NewSetFromSlice([]int{2, 4, 1 << 20})
+
+	// Test NewCompressedBitSetFromSet and ToSet
+	c, err := NewCompressedBitSetFromSet(s)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !c.ToSet().Equal(s) {
+		t.Errorf("Expected ToSet to equal the original set")
+	}
+
+	// Test round trip through BitSet
+	if !c.ToBitSet().Compress().Equal(c) {
+		t.Errorf("Expected round trip to equal the original set")
+	}
+
+	// Test Clone independence
+	clone := c.Clone()
+	clone.Add(3)
+	if c.Contains(3) {
+		t.Errorf("Expected the original set not to contain 3")
+	}
+
+	// Test conversion of an invalid set
+	if _, err := NewCompressedBitSetFromSet(NewSetFromSlice([]int{-1})); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestCompressedBitSetConformance(t *testing.T) {
+	// Test that CompressedBitSet behaves like every other SetLike
+	testkit.TestSet(t, func() collections.SetLike[int] { return NewCompressedBitSet() },
+		func(i int) int { return i * 70000 },
+		testkit.WithClone(func(c collections.Collection[int]) collections.Collection[int] {
+			return c.(*CompressedBitSet).Clone()
+		}))
+}
+
+func FuzzCompressedBitSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 70, 3, 70, 3, 127, 4, 0, 5, 0, 6, 0, 7, 0, 1, 1, 2, 1})
+	f.Add([]byte{8, 0, 3, 10, 9, 5, 4, 0, 7, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, data, NewCompressedBitSet, func(arg int) int { return (arg + 128) * 1021 })
+	})
+}
@@ -0,0 +1,297 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+)
+
+const (
+	// bucketSize is the number of fingerprints stored in each bucket of a CuckooFilter.
+	bucketSize = 4
+	// maxKicks is the number of times Add relocates fingerprints before giving up.
+	maxKicks = 500
+)
+
+// bucket holds up to bucketSize fingerprints; a zero fingerprint marks an empty slot.
+type bucket [bucketSize]uint16
+
+// victim holds a fingerprint that could not be placed after maxKicks relocations.
+type victim struct {
+	index       uint64
+	fingerprint uint16
+	used        bool
+}
+
+// CuckooFilter represents a probabilistic set that, unlike a BloomFilter, supports removing items.
+// Each item is stored as a 16-bit fingerprint in one of two candidate buckets.
+type CuckooFilter struct {
+	buckets []bucket
+	count   int
+	victim  victim
+	rand    *rand.Rand
+}
+
+// NewCuckooFilter creates a new CuckooFilter with room for at least capacity items.
+// It returns an error if capacity is not positive.
+func NewCuckooFilter(capacity int) (*CuckooFilter, error) {
+	if capacity <= 0 {
+		return nil, errors.New("invalid cuckoo filter capacity")
+	}
+	n := uint64(1)
+	for n*bucketSize < uint64(capacity) {
+		n <<= 1
+	}
+	return newCuckooFilter(n), nil
+}
+
+// newCuckooFilter creates a new CuckooFilter with n buckets, where n is a power of two.
+func newCuckooFilter(n uint64) *CuckooFilter {
+	return &CuckooFilter{
+		buckets: make([]bucket, n),
+		rand:    rand.New(rand.NewSource(int64(n))),
+	}
+}
+
+// indexAndFingerprint returns the primary bucket index and the fingerprint of item.
+func (f *CuckooFilter) indexAndFingerprint(item []byte) (uint64, uint16) {
+	h := hashItem(item)
+	fingerprint := uint16(h >> 48)
+	if fingerprint == 0 {
+		fingerprint = 1
+	}
+	return h & f.mask(), fingerprint
+}
+
+// mask returns the bit mask that reduces a hash to a bucket index.
+func (f *CuckooFilter) mask() uint64 {
+	return uint64(len(f.buckets)) - 1
+}
+
+// altIndex returns the other bucket index of a fingerprint stored at index.
+// Applying it twice returns the original index.
+func (f *CuckooFilter) altIndex(index uint64, fingerprint uint16) uint64 {
+	return (index ^ (uint64(fingerprint) * 0x5bd1e995)) & f.mask()
+}
+
+// Add adds an item to the filter.
+// It returns false if the filter is too full to store the item.
+func (f *CuckooFilter) Add(item []byte) bool {
+	index, fingerprint := f.indexAndFingerprint(item)
+	return f.insert(index, fingerprint)
+}
+
+// AddString adds a string item to the filter.
+// It returns false if the filter is too full to store the item.
+func (f *CuckooFilter) AddString(item string) bool {
+	return f.Add([]byte(item))
+}
+
+// insert stores fingerprint in the bucket at index or its alternate,
+// relocating existing fingerprints if both are full.
+func (f *CuckooFilter) insert(index uint64, fingerprint uint16) bool {
+	if f.victim.used {
+		return false
+	}
+	alt := f.altIndex(index, fingerprint)
+	if f.buckets[index].insert(fingerprint) || f.buckets[alt].insert(fingerprint) {
+		f.count++
+		return true
+	}
+	if f.rand.Intn(2) == 0 {
+		index = alt
+	}
+	for kick := 0; kick < maxKicks; kick++ {
+		slot := f.rand.Intn(bucketSize)
+		fingerprint, f.buckets[index][slot] = f.buckets[index][slot], fingerprint
+		index = f.altIndex(index, fingerprint)
+		if f.buckets[index].insert(fingerprint) {
+			f.count++
+			return true
+		}
+	}
+	f.victim = victim{index, fingerprint, true}
+	f.count++
+	return true
+}
+
+// Contains checks if the item may have been added to the filter.
+// It returns false only if the item was definitely never added or has been removed.
+func (f *CuckooFilter) Contains(item []byte) bool {
+	index, fingerprint := f.indexAndFingerprint(item)
+	alt := f.altIndex(index, fingerprint)
+	if f.victim.used && f.victim.fingerprint == fingerprint && (f.victim.index == index || f.victim.index == alt) {
+		return true
+	}
+	return f.buckets[index].contains(fingerprint) || f.buckets[alt].contains(fingerprint)
+}
+
+// ContainsString checks if the string item may have been added to the filter.
+func (f *CuckooFilter) ContainsString(item string) bool {
+	return f.Contains([]byte(item))
+}
+
+// Remove removes one copy of an item from the filter.
+// It returns false if the item was not found. Only items that were added may be removed,
+// otherwise a different item sharing the same fingerprint may be removed instead.
+func (f *CuckooFilter) Remove(item []byte) bool {
+	index, fingerprint := f.indexAndFingerprint(item)
+	alt := f.altIndex(index, fingerprint)
+	if f.victim.used && f.victim.fingerprint == fingerprint && (f.victim.index == index || f.victim.index == alt) {
+		f.victim = victim{}
+		f.count--
+		return true
+	}
+	if f.buckets[index].remove(fingerprint) || f.buckets[alt].remove(fingerprint) {
+		f.count--
+		f.reinsertVictim()
+		return true
+	}
+	return false
+}
+
+// RemoveString removes one copy of a string item from the filter.
+func (f *CuckooFilter) RemoveString(item string) bool {
+	return f.Remove([]byte(item))
+}
+
+// reinsertVictim tries to move the victim fingerprint back into the buckets after space was freed.
+func (f *CuckooFilter) reinsertVictim() {
+	if !f.victim.used {
+		return
+	}
+	v := f.victim
+	f.victim = victim{}
+	f.count--
+	f.insert(v.index, v.fingerprint)
+}
+
+// Len returns the number of items stored in the filter.
+func (f *CuckooFilter) Len() int {
+	return f.count
+}
+
+// Capacity returns the number of fingerprint slots in the filter.
+func (f *CuckooFilter) Capacity() int {
+	return len(f.buckets) * bucketSize
+}
+
+// FillRatio returns the fraction of fingerprint slots in the filter that are in use.
+func (f *CuckooFilter) FillRatio() float64 {
+	return float64(f.count) / float64(f.Capacity())
+}
+
+// Clear removes all items from the filter.
+func (f *CuckooFilter) Clear() {
+	for i := range f.buckets {
+		f.buckets[i] = bucket{}
+	}
+	f.count = 0
+	f.victim = victim{}
+}
+
+// Union returns a new filter containing the items of both the current filter and the other filter.
+// It returns an error if the filters do not have the same number of buckets,
+// or if the union does not fit in a filter of that size.
+func (f *CuckooFilter) Union(other *CuckooFilter) (*CuckooFilter, error) {
+	if len(f.buckets) != len(other.buckets) {
+		return nil, errors.New("incompatible filters")
+	}
+	union := newCuckooFilter(uint64(len(f.buckets)))
+	for _, source := range []*CuckooFilter{f, other} {
+		for index, b := range source.buckets {
+			for _, fingerprint := range b {
+				if fingerprint != 0 && !union.insert(uint64(index), fingerprint) {
+					return nil, errors.New("cuckoo filter is full")
+				}
+			}
+		}
+		if source.victim.used && !union.insert(source.victim.index, source.victim.fingerprint) {
+			return nil, errors.New("cuckoo filter is full")
+		}
+	}
+	return union, nil
+}
+
+// MarshalBinary encodes the filter into a binary form.
+func (f *CuckooFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 8+2*bucketSize*len(f.buckets))
+	data = binary.BigEndian.AppendUint64(data, uint64(len(f.buckets)))
+	for _, b := range f.buckets {
+		for _, fingerprint := range b {
+			data = binary.BigEndian.AppendUint16(data, fingerprint)
+		}
+	}
+	if f.victim.used {
+		data = binary.BigEndian.AppendUint64(data, f.victim.index)
+		data = binary.BigEndian.AppendUint16(data, f.victim.fingerprint)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the filter from the binary form produced by MarshalBinary.
+func (f *CuckooFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("invalid cuckoo filter encoding")
+	}
+	n := binary.BigEndian.Uint64(data)
+	data = data[8:]
+	// The number of buckets is compared with the length of data, so that a crafted n cannot overflow the check.
+	if n == 0 || n&(n-1) != 0 || n > uint64(len(data))/(2*bucketSize) {
+		return errors.New("invalid cuckoo filter encoding")
+	}
+	filter := newCuckooFilter(n)
+	for i := range filter.buckets {
+		for j := range filter.buckets[i] {
+			filter.buckets[i][j] = binary.BigEndian.Uint16(data)
+			data = data[2:]
+			if filter.buckets[i][j] != 0 {
+				filter.count++
+			}
+		}
+	}
+	switch len(data) {
+	case 0:
+	case 10:
+		filter.victim = victim{binary.BigEndian.Uint64(data) & filter.mask(), binary.BigEndian.Uint16(data[8:]), true}
+		filter.count++
+	default:
+		return errors.New("invalid cuckoo filter encoding")
+	}
+	*f = *filter
+	return nil
+}
+
+// insert stores fingerprint in a free slot of the bucket.
+// It returns false if the bucket is full.
+func (b *bucket) insert(fingerprint uint16) bool {
+	for i, slot := range b {
+		if slot == 0 {
+			b[i] = fingerprint
+			return true
+		}
+	}
+	return false
+}
+
+// contains checks if the bucket holds fingerprint.
+func (b *bucket) contains(fingerprint uint16) bool {
+	for _, slot := range b {
+		if slot == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// remove removes one copy of fingerprint from the bucket.
+// It returns false if the bucket does not hold fingerprint.
+func (b *bucket) remove(fingerprint uint16) bool {
+	for i, slot := range b {
+		if slot == fingerprint {
+			b[i] = 0
+			return true
+		}
+	}
+	return false
+}
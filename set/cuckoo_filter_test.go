@@ -0,0 +1,166 @@
+package set
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+func TestCuckooFilterAddContainsRemove(t *testing.T) {
+	// Create a new filter and add some items
+	f, err := NewCuckooFilter(1000)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	for i := 0; i < 900; i++ {
+		if !f.AddString(fmt.Sprintf("item-%d", i)) {
+			t.Errorf("Expected item-%d to be added", i)
+		}
+	}
+
+	// Test Len, Capacity and FillRatio
+	if f.Len() != 900 {
+		t.Errorf("Expected filter length to be 900, got %d", f.Len())
+	}
+	if f.Capacity() != 1024 {
+		t.Errorf("Expected filter capacity to be 1024, got %d", f.Capacity())
+	}
+	if f.FillRatio() != 900.0/1024 {
+		t.Errorf("Unexpected fill ratio %v", f.FillRatio())
+	}
+
+	// Test that every added item is found
+	for i := 0; i < 900; i++ {
+		if !f.ContainsString(fmt.Sprintf("item-%d", i)) {
+			t.Errorf("Expected filter to contain item-%d", i)
+		}
+	}
+
+	// Test Remove
+	for i := 0; i < 450; i++ {
+		if !f.RemoveString(fmt.Sprintf("item-%d", i)) {
+			t.Errorf("Expected item-%d to be removed", i)
+		}
+	}
+	if f.Len() != 450 {
+		t.Errorf("Expected filter length to be 450, got %d", f.Len())
+	}
+	for i := 450; i < 900; i++ {
+		if !f.ContainsString(fmt.Sprintf("item-%d", i)) {
+			t.Errorf("Expected filter to still contain item-%d", i)
+		}
+	}
+	removed := 0
+	for i := 0; i < 450; i++ {
+		if !f.ContainsString(fmt.Sprintf("item-%d", i)) {
+			removed++
+		}
+	}
+	if removed < 440 {
+		t.Errorf("Expected most removed items to be gone, only %d were", removed)
+	}
+
+	// Test invalid capacity
+	if _, err := NewCuckooFilter(0); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestCuckooFilterFull(t *testing.T) {
+	// Create a tiny filter and overfill it
+	f, _ := NewCuckooFilter(8)
+	added := 0
+	for i := 0; i < 100; i++ {
+		if f.AddString(fmt.Sprintf("item-%d", i)) {
+			added++
+		}
+	}
+	if added > f.Capacity()+1 {
+		t.Errorf("Expected at most %d items to be added, got %d", f.Capacity()+1, added)
+	}
+	if f.Len() != added {
+		t.Errorf("Expected filter length to be %d, got %d", added, f.Len())
+	}
+}
+
+func TestCuckooFilterUnion(t *testing.T) {
+	// Create two compatible filters
+	f1, _ := NewCuckooFilter(100)
+	f2, _ := NewCuckooFilter(100)
+	f1.AddString("a")
+	f2.AddString("b")
+
+	// Test Union
+	union, err := f1.Union(f2)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !union.ContainsString("a") || !union.ContainsString("b") || union.Len() != 2 {
+		t.Errorf("Expected union to contain a and b")
+	}
+
+	// Test Union of incompatible filters
+	f3, _ := NewCuckooFilter(1000)
+	if _, err := f1.Union(f3); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+}
+
+func TestCuckooFilterBinary(t *testing.T) {
+	// Create a new filter and add some items
+	f, _ := NewCuckooFilter(100)
+	f.AddString("a")
+	f.AddString("b")
+
+	// Test round trip
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	decoded := &CuckooFilter{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !decoded.ContainsString("a") || !decoded.ContainsString("b") || decoded.Len() != 2 {
+		t.Errorf("Expected decoded filter to match the original")
+	}
+	if !decoded.RemoveString("a") || decoded.ContainsString("a") {
+		t.Errorf("Expected a to be removed from the decoded filter")
+	}
+
+	// Test truncated data
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected an error, got nil")
+	}
+
+	// Test bucket counts that do not match the data, including counts that overflow when multiplied
+	for _, n := range []uint64{1 << 61, 1 << 62, 1 << 63, 4} {
+		header := binary.BigEndian.AppendUint64(nil, n)
+		for _, size := range []int{0, 8, 18} {
+			if err := decoded.UnmarshalBinary(append(header, make([]byte, size)...)); err == nil {
+				t.Errorf("Expected an error for %d buckets with %d bytes, got nil", n, size)
+			}
+		}
+	}
+}
+
+func FuzzCuckooFilterUnmarshal(f *testing.F) {
+	valid, _ := NewCuckooFilter(8)
+	valid.AddString("a")
+	data, _ := valid.MarshalBinary()
+	f.Add(data)
+	f.Add(binary.BigEndian.AppendUint64(nil, 1<<61))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Decoding any data must either fail or give a filter that can be used and encoded again
+		decoded := &CuckooFilter{}
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			return
+		}
+		decoded.ContainsString("a")
+		decoded.RemoveString("a")
+		encoded, _ := decoded.MarshalBinary()
+		if err := (&CuckooFilter{}).UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("Expected the encoding of a decoded filter to decode, got %v", err)
+		}
+	})
+}
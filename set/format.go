@@ -0,0 +1,89 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// compareItems orders two items for a deterministic output. Items of different kinds are ordered by kind.
+// Booleans, numbers and strings are ordered by value, and other items by their fmt representation,
+// then by their type, so that equal representations of different values still have a fixed order.
+func compareItems(a, b any) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if c := cmp.Compare(va.Kind(), vb.Kind()); c != 0 {
+		return c
+	}
+	switch va.Kind() {
+	case reflect.Invalid:
+		return 0
+	case reflect.Bool:
+		return cmp.Compare(boolToInt(va.Bool()), boolToInt(vb.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if c := cmp.Compare(va.Int(), vb.Int()); c != 0 {
+			return c
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if c := cmp.Compare(va.Uint(), vb.Uint()); c != 0 {
+			return c
+		}
+	case reflect.Float32, reflect.Float64:
+		if c := cmp.Compare(va.Float(), vb.Float()); c != 0 {
+			return c
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if c := cmp.Compare(real(va.Complex()), real(vb.Complex())); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(imag(va.Complex()), imag(vb.Complex())); c != 0 {
+			return c
+		}
+	case reflect.String:
+		if c := cmp.Compare(va.String(), vb.String()); c != 0 {
+			return c
+		}
+	default:
+		if c := cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)); c != 0 {
+			return c
+		}
+	}
+	if c := cmp.Compare(va.Type().String(), vb.Type().String()); c != 0 {
+		return c
+	}
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+// boolToInt returns 1 for true and 0 for false.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// sortedValues returns the elements of the set in the order of compareItems.
+func (s *Set[T]) sortedValues() []T {
+	values := s.ToSlice()
+	slices.SortFunc(values, func(a, b T) int {
+		return compareItems(a, b)
+	})
+	return values
+}
+
+// Format implements fmt.Formatter, so that a set prints its elements in a deterministic order, as String does.
+// The %v verb prints the elements like a slice, %+v also prints the size of the set, and %#v prints a Go
+// expression that creates the set. Other verbs, such as %d or %q, are applied to each element.
+func (s *Set[T]) Format(f fmt.State, verb rune) {
+	values := s.sortedValues()
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "set.NewSetFromSlice(%#v)", values)
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{size:%d elements:", len(values))
+		fmt.Fprintf(f, fmt.FormatString(f, verb), values)
+		fmt.Fprint(f, "}")
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), values)
+	}
+}
@@ -0,0 +1,137 @@
+package set
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// MinHash represents a fixed-size signature of a set of items that estimates the Jaccard similarity
+// between large sets without comparing their items. For each of its k hash functions, the signature
+// keeps the smallest hash of any added item; two sets agree on a position with a probability equal to
+// their Jaccard similarity. The standard error of the estimate is about 1/sqrt(k).
+type MinHash struct {
+	mins []uint64 // The smallest hash of any added item, for each hash function.
+}
+
+// NewMinHash creates a new MinHash signature with k hash functions.
+// It returns an error if k is not positive.
+func NewMinHash(k int) (*MinHash, error) {
+	if k <= 0 {
+		return nil, errors.New("invalid minhash size")
+	}
+	m := &MinHash{mins: make([]uint64, k)}
+	m.Clear()
+	return m, nil
+}
+
+// MinHashOf creates a new MinHash signature with k hash functions of the items of the set.
+// key must encode each item into bytes, such that equal items have equal keys and different items
+// different keys; signatures are only comparable if they were built with the same key function.
+// It returns an error if k is not positive.
+func MinHashOf[T comparable](s *Set[T], k int, key func(item T) []byte) (*MinHash, error) {
+	m, err := NewMinHash(k)
+	if err != nil {
+		return nil, err
+	}
+	for item := range s.elements {
+		m.Add(key(item))
+	}
+	return m, nil
+}
+
+// mix64 scrambles the bits of x with the finalizer of SplitMix64, which is a bijection.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Add adds an item to the signature.
+func (m *MinHash) Add(item []byte) {
+	h := hashItem(item)
+	for i := range m.mins {
+		// Each hash function offsets the hash of the item by a different multiple of the golden ratio before mixing.
+		if v := mix64(h + uint64(i+1)*0x9e3779b97f4a7c15); v < m.mins[i] {
+			m.mins[i] = v
+		}
+	}
+}
+
+// AddString adds a string item to the signature.
+func (m *MinHash) AddString(item string) {
+	m.Add([]byte(item))
+}
+
+// Size returns the number of hash functions, k, of the signature.
+func (m *MinHash) Size() int {
+	return len(m.mins)
+}
+
+// Clear removes all items from the signature.
+func (m *MinHash) Clear() {
+	for i := range m.mins {
+		m.mins[i] = math.MaxUint64
+	}
+}
+
+// Similarity returns the estimated Jaccard similarity of the sets of items added to the current signature
+// and the other signature: the fraction of hash functions on which they agree. Two empty signatures agree everywhere.
+// It returns an error if the signatures do not have the same size.
+func (m *MinHash) Similarity(other *MinHash) (float64, error) {
+	if len(m.mins) != len(other.mins) {
+		return 0, errors.New("incompatible signatures")
+	}
+	equal := 0
+	for i, v := range m.mins {
+		if v == other.mins[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(m.mins)), nil
+}
+
+// Union returns a new signature of the union of the sets of items added to the current signature
+// and the other signature, as if every item had been added to one signature.
+// It returns an error if the signatures do not have the same size.
+func (m *MinHash) Union(other *MinHash) (*MinHash, error) {
+	if len(m.mins) != len(other.mins) {
+		return nil, errors.New("incompatible signatures")
+	}
+	union := &MinHash{mins: make([]uint64, len(m.mins))}
+	for i, v := range m.mins {
+		union.mins[i] = min(v, other.mins[i])
+	}
+	return union, nil
+}
+
+// MarshalBinary encodes the signature into a binary form.
+func (m *MinHash) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 8+8*len(m.mins))
+	data = binary.BigEndian.AppendUint64(data, uint64(len(m.mins)))
+	for _, v := range m.mins {
+		data = binary.BigEndian.AppendUint64(data, v)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the signature from the binary form produced by MarshalBinary.
+func (m *MinHash) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("invalid minhash encoding")
+	}
+	k := binary.BigEndian.Uint64(data)
+	data = data[8:]
+	if k == 0 || len(data)%8 != 0 || uint64(len(data)/8) != k {
+		return errors.New("invalid minhash encoding")
+	}
+	mins := make([]uint64, k)
+	for i := range mins {
+		mins[i] = binary.BigEndian.Uint64(data[8*i:])
+	}
+	m.mins = mins
+	return nil
+}
@@ -0,0 +1,146 @@
+package set
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// newTestMinHash returns a signature with k hash functions of the items from item-from to item-(to-1).
+func newTestMinHash(t *testing.T, k, from, to int) *MinHash {
+	m, err := NewMinHash(k)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i := from; i < to; i++ {
+		m.AddString(fmt.Sprintf("item-%d", i))
+	}
+	return m
+}
+
+func TestNewMinHash(t *testing.T) {
+	// Test that the size must be positive
+	if _, err := NewMinHash(0); err == nil {
+		t.Errorf("Expected an error for a size of 0")
+	}
+	m := newTestMinHash(t, 64, 0, 0)
+	if m.Size() != 64 {
+		t.Errorf("Expected size 64, got %d", m.Size())
+	}
+}
+
+func TestMinHashSimilarity(t *testing.T) {
+	// Test that the estimate is close to the exact Jaccard similarity
+	tests := []struct {
+		from, to int
+		expected float64
+	}{
+		{0, 2000, 1},
+		{500, 2500, 1500.0 / 2500},
+		{1000, 3000, 1000.0 / 3000},
+		{2000, 4000, 0},
+	}
+	a := newTestMinHash(t, 256, 0, 2000)
+	for _, test := range tests {
+		b := newTestMinHash(t, 256, test.from, test.to)
+		got, err := a.Similarity(b)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if math.Abs(got-test.expected) > 0.1 {
+			t.Errorf("Expected a similarity close to %v for items %d to %d, got %v", test.expected, test.from, test.to, got)
+		}
+	}
+
+	// Test empty signatures and signatures of different sizes
+	empty := newTestMinHash(t, 256, 0, 0)
+	if got, _ := empty.Similarity(newTestMinHash(t, 256, 0, 0)); got != 1 {
+		t.Errorf("Expected two empty signatures to be equal, got %v", got)
+	}
+	if got, _ := empty.Similarity(a); got != 0 {
+		t.Errorf("Expected an empty and a non-empty signature to differ, got %v", got)
+	}
+	if _, err := a.Similarity(newTestMinHash(t, 128, 0, 2000)); err == nil {
+		t.Errorf("Expected an error for signatures of different sizes")
+	}
+}
+
+func TestMinHashUnion(t *testing.T) {
+	// Test that the union of two signatures is the signature of the union of their items
+	union, err := newTestMinHash(t, 64, 0, 100).Union(newTestMinHash(t, 64, 50, 200))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got, _ := union.Similarity(newTestMinHash(t, 64, 0, 200)); got != 1 {
+		t.Errorf("Expected the union to equal the signature of all items, got similarity %v", got)
+	}
+	if _, err := union.Union(newTestMinHash(t, 32, 0, 1)); err == nil {
+		t.Errorf("Expected an error for signatures of different sizes")
+	}
+
+	// Test that Clear empties the signature
+	union.Clear()
+	if got, _ := union.Similarity(newTestMinHash(t, 64, 0, 0)); got != 1 {
+		t.Errorf("Expected a cleared signature to be empty, got similarity %v", got)
+	}
+}
+
+func TestMinHashBinary(t *testing.T) {
+	// Test that a signature survives encoding and decoding
+	m := newTestMinHash(t, 32, 0, 100)
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var decoded MinHash
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got, _ := decoded.Similarity(m); got != 1 || decoded.Size() != 32 {
+		t.Errorf("Expected the decoded signature to equal the original, got similarity %v and size %d", got, decoded.Size())
+	}
+
+	// Test invalid encodings
+	for _, data := range [][]byte{nil, data[:8], data[:len(data)-1], make([]byte, 8)} {
+		if err := decoded.UnmarshalBinary(data); err == nil {
+			t.Errorf("Expected an error for an encoding of %d bytes", len(data))
+		}
+	}
+}
+
+func TestMinHashOf(t *testing.T) {
+	// Test that the estimate from the signatures of two sets is close to their exact Jaccard similarity
+	key := func(item int) []byte { return binary.BigEndian.AppendUint64(nil, uint64(item)) }
+	newSet := func(from, to int) *Set[int] {
+		s := NewSet[int]()
+		for i := from; i < to; i++ {
+			s.Add(i)
+		}
+		return s
+	}
+	a := newSet(0, 2000)
+	ma, err := MinHashOf(a, 256, key)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, b := range []*Set[int]{newSet(0, 2000), newSet(500, 2500), newSet(1500, 4000), newSet(3000, 4000)} {
+		mb, _ := MinHashOf(b, 256, key)
+		got, _ := ma.Similarity(mb)
+		if expected := a.Jaccard(b); math.Abs(got-expected) > 0.1 {
+			t.Errorf("Expected a similarity close to %v, got %v", expected, got)
+		}
+	}
+
+	// Test that the signature is the same as adding the keys one by one, and that the size must be positive
+	m, _ := NewMinHash(256)
+	for i := 0; i < 2000; i++ {
+		m.Add(key(i))
+	}
+	if got, _ := m.Similarity(ma); got != 1 {
+		t.Errorf("Expected the same signature as adding the keys, got a similarity of %v", got)
+	}
+	if _, err := MinHashOf(a, 0, key); err == nil {
+		t.Errorf("Expected an error for a size of 0")
+	}
+}
@@ -1,69 +1,167 @@
 package set
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
 
-type OrderedSet struct {
-	elements []interface{}
+	"goCollections/collections"
+)
+
+// OrderedSet is a collection of unique values of type T that keeps them in insertion order.
+type OrderedSet[T comparable] struct {
+	elements  []T
+	equal     EqualFunc[T]             // Compares elements; nil means ==.
+	observers collections.Observers[T] // The subscribers to changes of the set.
+}
+
+var _ collections.SetLike[int] = (*OrderedSet[int])(nil)
+
+// EqualFunc reports whether two values are equal.
+type EqualFunc[T any] func(a, b T) bool
+
+// Option configures an OrderedSet.
+type Option[T any] func(*config[T])
+
+// config holds the settings of an OrderedSet.
+type config[T any] struct {
+	equal EqualFunc[T]
+}
+
+// WithEqual sets the function the set uses to compare elements, instead of ==.
+// It allows matching structs by a field, or storing values such as slices that == cannot compare.
+// Sets created from the set, such as the result of Union, use the same function.
+func WithEqual[T any](equal EqualFunc[T]) Option[T] {
+	return func(c *config[T]) {
+		c.equal = equal
+	}
 }
 
 // NewOrderedSet creates a new OrderedSet.
-func NewOrderedSet() *OrderedSet {
-	return &OrderedSet{make([]interface{}, 0)}
+func NewOrderedSet[T comparable](opts ...Option[T]) *OrderedSet[T] {
+	var c config[T]
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &OrderedSet[T]{elements: make([]T, 0), equal: c.equal}
+}
+
+// empty returns a new empty set that compares elements like s.
+func (s *OrderedSet[T]) empty() *OrderedSet[T] {
+	return NewOrderedSet(WithEqual(s.equal))
+}
+
+// equals compares two elements with the equality function of the set.
+func (s *OrderedSet[T]) equals(a, b T) bool {
+	if s.equal == nil {
+		return a == b
+	}
+	return s.equal(a, b)
 }
 
 // Add adds an item to the set.
 // The item parameter is the value to be added to the set.
-func (s *OrderedSet) Add(item interface{}) {
+func (s *OrderedSet[T]) Add(item T) {
 	if !s.Contains(item) {
 		s.elements = append(s.elements, item)
+		s.observers.Emit(collections.Event[T]{Kind: collections.Added, Index: len(s.elements) - 1, Value: item})
 	}
 }
 
 // Remove removes the specified item from the set.
-func (s *OrderedSet) Remove(item interface{}) {
-	for i, element := range s.elements {
-		if element == item {
-			s.elements = append(s.elements[:i], s.elements[i+1:]...)
-			break
-		}
+func (s *OrderedSet[T]) Remove(item T) {
+	s.RemoveFunc(func(element T) bool {
+		return s.equals(element, item)
+	})
+}
+
+// RemoveFunc removes the first element for which match returns true.
+// It returns true if an element was removed, otherwise false.
+func (s *OrderedSet[T]) RemoveFunc(match func(element T) bool) bool {
+	i := s.IndexFunc(match)
+	if i == -1 {
+		return false
 	}
+	item := s.elements[i]
+	s.elements = append(s.elements[:i], s.elements[i+1:]...)
+	s.observers.Emit(collections.Event[T]{Kind: collections.Removed, Index: i, Value: item})
+	return true
+}
+
+// RemoveAllFunc removes every element for which match returns true, keeping the order of the others.
+// It returns the number of removed elements.
+func (s *OrderedSet[T]) RemoveAllFunc(match func(element T) bool) int {
+	removed := 0
+	// The removals are delivered when the batch ends, after the set holds the remaining elements.
+	s.observers.Batch(func() {
+		n := 0
+		for _, element := range s.elements {
+			if !match(element) {
+				s.elements[n] = element
+				n++
+			} else {
+				s.observers.Emit(collections.Event[T]{Kind: collections.Removed, Index: n, Value: element})
+			}
+		}
+		removed = len(s.elements) - n
+		clear(s.elements[n:])
+		s.elements = s.elements[:n]
+	})
+	return removed
 }
 
 // Get returns the item at the specified index.
-// It returns the item at the specified index if the index is valid, otherwise it returns nil.
-func (s *OrderedSet) Get(index int) interface{} {
+// It returns the item at the specified index if the index is valid, otherwise it returns the zero value of T.
+func (s *OrderedSet[T]) Get(index int) T {
 	if index < 0 || index >= s.Len() {
-		return nil
+		var zero T
+		return zero
 	}
 	return s.elements[index]
 }
 
 // Contains checks if the Set contains the specified item.
 // It returns true if the item is found in the Set, otherwise it returns false.
-func (s *OrderedSet) Contains(item interface{}) bool {
-	for _, element := range s.elements {
-		if element == item {
-			return true
+func (s *OrderedSet[T]) Contains(item T) bool {
+	return s.ContainsFunc(func(element T) bool {
+		return s.equals(element, item)
+	})
+}
+
+// ContainsFunc checks if the set contains an element for which match returns true.
+func (s *OrderedSet[T]) ContainsFunc(match func(element T) bool) bool {
+	return s.IndexFunc(match) != -1
+}
+
+// IndexFunc returns the index of the first element for which match returns true.
+// If there is no such element, it returns -1.
+func (s *OrderedSet[T]) IndexFunc(match func(element T) bool) int {
+	for i, element := range s.elements {
+		if match(element) {
+			return i
 		}
 	}
-	return false
+	return -1
 }
 
 // Len returns the number of elements in the set.
 // It returns an integer representing the size of the set.
-func (s *OrderedSet) Len() int {
+func (s *OrderedSet[T]) Len() int {
 	return len(s.elements)
 }
 
 // Clear removes all elements from the set.
-func (s *OrderedSet) Clear() {
-	s.elements = make([]interface{}, 0)
+func (s *OrderedSet[T]) Clear() {
+	if len(s.elements) == 0 {
+		return
+	}
+	s.elements = make([]T, 0)
+	s.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
 }
 
 // Equal checks if the current set is equal to another set.
 // It returns true if the sets have the same length and contain the same elements,
 // otherwise it returns false.
-func (s *OrderedSet) Equal(other *OrderedSet) bool {
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
 	if s.Len() != other.Len() {
 		return false
 	}
@@ -75,20 +173,26 @@ func (s *OrderedSet) Equal(other *OrderedSet) bool {
 	return true
 }
 
+// Values returns a new slice containing the elements of the set in insertion order.
+// Unlike ToSlice, modifying the returned slice does not modify the set.
+func (s *OrderedSet[T]) Values() []T {
+	return slices.Clone(s.elements)
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 // The order of the elements in the slice is guaranteed.
-func (s *OrderedSet) ToSlice() []interface{} {
+func (s *OrderedSet[T]) ToSlice() []T {
 	return s.elements
 }
 
 // String returns a string representation of the set.
-func (s *OrderedSet) String() string {
+func (s *OrderedSet[T]) String() string {
 	return fmt.Sprintf("%v", s.elements)
 }
 
 // Union returns a new set containing all the elements in the current set and the other set.
-func (s *OrderedSet) Union(other *OrderedSet) *OrderedSet {
-	union := NewOrderedSet()
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	union := s.empty()
 	for _, element := range s.elements {
 		union.Add(element)
 	}
@@ -99,8 +203,8 @@ func (s *OrderedSet) Union(other *OrderedSet) *OrderedSet {
 }
 
 // Intersection returns a new set containing the elements that are in both the current set and the other set.
-func (s *OrderedSet) Intersection(other *OrderedSet) *OrderedSet {
-	intersection := NewOrderedSet()
+func (s *OrderedSet[T]) Intersection(other *OrderedSet[T]) *OrderedSet[T] {
+	intersection := s.empty()
 	for _, element := range s.elements {
 		if other.Contains(element) {
 			intersection.Add(element)
@@ -110,8 +214,8 @@ func (s *OrderedSet) Intersection(other *OrderedSet) *OrderedSet {
 }
 
 // Difference returns a new set containing the elements that are in the current set but not in the other set.
-func (s *OrderedSet) Difference(other *OrderedSet) *OrderedSet {
-	difference := NewOrderedSet()
+func (s *OrderedSet[T]) Difference(other *OrderedSet[T]) *OrderedSet[T] {
+	difference := s.empty()
 	for _, element := range s.elements {
 		if !other.Contains(element) {
 			difference.Add(element)
@@ -121,8 +225,8 @@ func (s *OrderedSet) Difference(other *OrderedSet) *OrderedSet {
 }
 
 // SymmetricDifference returns a new set containing the elements that are in the current set or in the other set but not in both.
-func (s *OrderedSet) SymmetricDifference(other *OrderedSet) *OrderedSet {
-	symDiff := NewOrderedSet()
+func (s *OrderedSet[T]) SymmetricDifference(other *OrderedSet[T]) *OrderedSet[T] {
+	symDiff := s.empty()
 	for _, element := range s.elements {
 		if !other.Contains(element) {
 			symDiff.Add(element)
@@ -136,8 +240,119 @@ func (s *OrderedSet) SymmetricDifference(other *OrderedSet) *OrderedSet {
 	return symDiff
 }
 
+// UnionAllOrdered returns a new OrderedSet that contains all the elements of every given set,
+// in the order they first appear when the sets are read in turn. The result compares elements
+// like the first set, and is an empty OrderedSet if no sets are given.
+func UnionAllOrdered[T comparable](sets ...*OrderedSet[T]) *OrderedSet[T] {
+	if len(sets) == 0 {
+		return NewOrderedSet[T]()
+	}
+	union := sets[0].empty()
+	for _, set := range sets {
+		for _, element := range set.elements {
+			union.Add(element)
+		}
+	}
+	return union
+}
+
+// IntersectAllOrdered returns a new OrderedSet that contains the elements present in every given set,
+// or an empty OrderedSet if no sets are given. It only iterates over the smallest set, so the result
+// keeps the order of the smallest set, the first one if several have the same size, and compares
+// elements like it.
+func IntersectAllOrdered[T comparable](sets ...*OrderedSet[T]) *OrderedSet[T] {
+	if len(sets) == 0 {
+		return NewOrderedSet[T]()
+	}
+	smallest := sets[0]
+	for _, set := range sets[1:] {
+		if set.Len() < smallest.Len() {
+			smallest = set
+		}
+	}
+	intersection := smallest.empty()
+	for _, element := range smallest.elements {
+		if containsAllOrdered(sets, element) {
+			intersection.elements = append(intersection.elements, element)
+		}
+	}
+	return intersection
+}
+
+// containsAllOrdered checks if every set in sets contains item.
+func containsAllOrdered[T comparable](sets []*OrderedSet[T], item T) bool {
+	for _, set := range sets {
+		if !set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnionWith appends the elements of the other sets that are not in the set to it, in place, in their order.
+// Calling it on an empty set computes the union of many sets without intermediate sets.
+// Subscribers receive the changes as a single notification.
+func (s *OrderedSet[T]) UnionWith(others ...*OrderedSet[T]) {
+	s.observers.Batch(func() {
+		for _, other := range others {
+			if other == s {
+				continue
+			}
+			for _, element := range other.elements {
+				s.Add(element)
+			}
+		}
+	})
+}
+
+// IntersectWith removes the elements that are not in every other set from the set, in place,
+// keeping the order of the remaining ones. Subscribers receive the changes as a single notification.
+func (s *OrderedSet[T]) IntersectWith(others ...*OrderedSet[T]) {
+	s.RemoveAllFunc(func(element T) bool {
+		for _, other := range others {
+			if !other.Contains(element) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// DifferenceWith removes the elements that are in any other set from the set, in place,
+// keeping the order of the remaining ones. Subscribers receive the changes as a single notification.
+func (s *OrderedSet[T]) DifferenceWith(others ...*OrderedSet[T]) {
+	s.RemoveAllFunc(func(element T) bool {
+		for _, other := range others {
+			if other.Contains(element) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// SymmetricDifferenceWith replaces the set, in place, with its symmetric difference with each other set in turn:
+// the elements of another set are removed if the set contains them, and appended otherwise.
+// The result holds the elements that are in an odd number of the sets, in the same order as SymmetricDifference.
+// Subscribers receive the changes as a single notification.
+func (s *OrderedSet[T]) SymmetricDifferenceWith(others ...*OrderedSet[T]) {
+	s.observers.Batch(func() {
+		for _, other := range others {
+			if other == s {
+				s.Clear()
+				continue
+			}
+			for _, element := range other.elements {
+				if !s.RemoveFunc(func(e T) bool { return s.equals(e, element) }) {
+					s.Add(element)
+				}
+			}
+		}
+	})
+}
+
 // IsSubset checks if the current set is a subset of the other set.
-func (s *OrderedSet) IsSubset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsSubset(other *OrderedSet[T]) bool {
 	for _, element := range s.elements {
 		if !other.Contains(element) {
 			return false
@@ -147,12 +362,12 @@ func (s *OrderedSet) IsSubset(other *OrderedSet) bool {
 }
 
 // IsSuperset checks if the current set is a superset of the other set.
-func (s *OrderedSet) IsSuperset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsSuperset(other *OrderedSet[T]) bool {
 	return other.IsSubset(s)
 }
 
 // IsDisjoint checks if the current set and the other set are disjoint.
-func (s *OrderedSet) IsDisjoint(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsDisjoint(other *OrderedSet[T]) bool {
 	for _, element := range s.elements {
 		if other.Contains(element) {
 			return false
@@ -162,8 +377,8 @@ func (s *OrderedSet) IsDisjoint(other *OrderedSet) bool {
 }
 
 // Clone creates a new set that is a copy of the current set.
-func (s *OrderedSet) Clone() *OrderedSet {
-	clone := NewOrderedSet()
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	clone := s.empty()
 	for _, element := range s.elements {
 		clone.Add(element)
 	}
@@ -171,19 +386,19 @@ func (s *OrderedSet) Clone() *OrderedSet {
 }
 
 // IsProperSubset checks if the current set is a proper subset of the other set.
-func (s *OrderedSet) IsProperSubset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsProperSubset(other *OrderedSet[T]) bool {
 	return s.IsSubset(other) && !s.Equal(other)
 }
 
 // IsProperSuperset checks if the current set is a proper superset of the other set.
-func (s *OrderedSet) IsProperSuperset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsProperSuperset(other *OrderedSet[T]) bool {
 	return s.IsSuperset(other) && !s.Equal(other)
 }
 
 // PowerSet returns the power set of the current set.
-func (s *OrderedSet) PowerSet() []*OrderedSet {
-	powerSet := make([]*OrderedSet, 0)
-	powerSet = append(powerSet, NewOrderedSet())
+func (s *OrderedSet[T]) PowerSet() []*OrderedSet[T] {
+	powerSet := make([]*OrderedSet[T], 0)
+	powerSet = append(powerSet, s.empty())
 	for _, item := range s.ToSlice() {
 		for _, subset := range powerSet {
 			newSubset := subset.Clone()
@@ -195,11 +410,11 @@ func (s *OrderedSet) PowerSet() []*OrderedSet {
 }
 
 // CartesianProduct returns the Cartesian product of the current set and the other set.
-func (s *OrderedSet) CartesianProduct(other *OrderedSet) []*OrderedSet {
-	cartesianProduct := make([]*OrderedSet, 0)
+func (s *OrderedSet[T]) CartesianProduct(other *OrderedSet[T]) []*OrderedSet[T] {
+	cartesianProduct := make([]*OrderedSet[T], 0)
 	for _, element := range s.elements {
 		for _, element2 := range other.elements {
-			product := NewOrderedSet()
+			product := s.empty()
 			product.Add(element)
 			product.Add(element2)
 			cartesianProduct = append(cartesianProduct, product)
@@ -209,22 +424,24 @@ func (s *OrderedSet) CartesianProduct(other *OrderedSet) []*OrderedSet {
 }
 
 // DisjointUnion returns the disjoint union of the current set and the other set.
-func (s *OrderedSet) DisjointUnion(other *OrderedSet) []*OrderedSet {
-	disjointUnion := make([]*OrderedSet, 0)
+func (s *OrderedSet[T]) DisjointUnion(other *OrderedSet[T]) []*OrderedSet[T] {
+	disjointUnion := make([]*OrderedSet[T], 0)
 	disjointUnion = append(disjointUnion, s)
 	disjointUnion = append(disjointUnion, other)
 	return disjointUnion
 }
 
 // IsEmpty checks if the current set is empty.
-func (s *OrderedSet) IsEmpty() bool {
+func (s *OrderedSet[T]) IsEmpty() bool {
 	return s.Len() == 0
 }
 
 // Pop removes and returns an arbitrary item from the set.
-func (s *OrderedSet) Pop() interface{} {
+// If the set is empty, it returns the zero value of T.
+func (s *OrderedSet[T]) Pop() T {
 	if s.IsEmpty() {
-		return nil
+		var zero T
+		return zero
 	}
 	item := s.elements[0]
 	s.Remove(item)
@@ -232,23 +449,29 @@ func (s *OrderedSet) Pop() interface{} {
 }
 
 // IsEqual checks if the current set is equal to the other set.
-func (s *OrderedSet) IsEqual(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsEqual(other *OrderedSet[T]) bool {
 	return s.Equal(other)
 }
 
 // IsStrictSubset checks if the current set is a strict subset of the other set.
-func (s *OrderedSet) IsStrictSubset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsStrictSubset(other *OrderedSet[T]) bool {
 	return s.IsProperSubset(other)
 }
 
 // IsStrictSuperset checks if the current set is a strict superset of the other set.
-func (s *OrderedSet) IsStrictSuperset(other *OrderedSet) bool {
+func (s *OrderedSet[T]) IsStrictSuperset(other *OrderedSet[T]) bool {
 	return s.IsProperSuperset(other)
 }
 
 // Copy returns a new OrderedSet that is a copy of the original OrderedSet.
 // The elements in the new OrderedSet are the same as the elements in the original OrderedSet.
 // Changes made to the new OrderedSet will not affect the original OrderedSet.
-func (s *OrderedSet) Copy() *OrderedSet {
+func (s *OrderedSet[T]) Copy() *OrderedSet[T] {
 	return s.Clone()
 }
+
+// Subscribe registers fn to be called with an event after every change to the set, and returns
+// a function that unregisters it. RemoveAllFunc delivers its changes as a single Batch event.
+func (s *OrderedSet[T]) Subscribe(fn func(event collections.Event[T])) (unsubscribe func()) {
+	return s.observers.Subscribe(fn)
+}
@@ -1,13 +1,19 @@
 package set
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
 )
 
 func TestOrderedSetCartesianProduct(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -43,8 +49,8 @@ func TestOrderedSetCartesianProduct(t *testing.T) {
 
 func TestOrderedSetDifference(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -73,8 +79,8 @@ func TestOrderedSetDifference(t *testing.T) {
 
 func TestOrderedSetIntersection(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -106,8 +112,8 @@ func TestOrderedSetIntersection(t *testing.T) {
 
 func TestOrderedSetIsSuperset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -129,8 +135,8 @@ func TestOrderedSetIsSuperset(t *testing.T) {
 
 func TestOrderedSetSymmetricDifference(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -162,8 +168,8 @@ func TestOrderedSetSymmetricDifference(t *testing.T) {
 
 func TestOrderedSetUnion(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -201,7 +207,7 @@ func TestOrderedSetUnion(t *testing.T) {
 
 func TestOrderedSetToSlice(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -229,9 +235,28 @@ func TestOrderedSetToSlice(t *testing.T) {
 	}
 }
 
+func TestOrderedSetValues(t *testing.T) {
+	// Create a new OrderedSet
+	s := NewOrderedSet[int]()
+	s.Add(1)
+	s.Add(2)
+
+	// Test that Values returns the elements in insertion order
+	values := s.Values()
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", values)
+	}
+
+	// Test that modifying the returned slice does not modify the set
+	values[0] = 3
+	if s.Get(0) != 1 {
+		t.Errorf("Expected element at index 0 to still be 1, got %v", s.Get(0))
+	}
+}
+
 func TestOrderedSetString(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -250,7 +275,7 @@ func TestOrderedSetString(t *testing.T) {
 
 func TestOrderedSetClear(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -269,7 +294,7 @@ func TestOrderedSetClear(t *testing.T) {
 
 func TestOrderedSetContains(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -290,7 +315,7 @@ func TestOrderedSetContains(t *testing.T) {
 
 func TestOrderedSetCopy(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -314,7 +339,7 @@ func TestOrderedSetCopy(t *testing.T) {
 
 func TestOrderedSetPowerSet(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -340,7 +365,7 @@ func TestOrderedSetPowerSet(t *testing.T) {
 
 func TestOrderedSetRemove(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[string]()
 
 	// Add some elements to the set
 	s.Add("a")
@@ -370,7 +395,7 @@ func TestOrderedSetRemove(t *testing.T) {
 
 func TestOrderedSetLen(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Test the length of the set
 	expectedLength := 0
@@ -381,7 +406,7 @@ func TestOrderedSetLen(t *testing.T) {
 
 func TestOrderedSetAdd(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add an element to the set
 	s.Add(1)
@@ -400,7 +425,7 @@ func TestOrderedSetAdd(t *testing.T) {
 
 func TestOrderedSetGet(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -410,13 +435,13 @@ func TestOrderedSetGet(t *testing.T) {
 	// Test getting elements at different indices
 	tests := []struct {
 		index          int
-		expectedResult interface{}
+		expectedResult int
 	}{
 		{0, 1},
 		{1, 2},
 		{2, 3},
-		{3, nil},  // Index out of range
-		{-1, nil}, // Index out of range
+		{3, 0},  // Index out of range
+		{-1, 0}, // Index out of range
 	}
 
 	for _, test := range tests {
@@ -428,8 +453,8 @@ func TestOrderedSetGet(t *testing.T) {
 }
 func TestOrderedSetEqual(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -457,8 +482,8 @@ func TestOrderedSetEqual(t *testing.T) {
 
 func TestOrderedSetIsSubset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -478,8 +503,8 @@ func TestOrderedSetIsSubset(t *testing.T) {
 	}
 
 	// Create two new instances of the OrderedSet struct
-	s3 := NewOrderedSet()
-	s4 := NewOrderedSet()
+	s3 := NewOrderedSet[int]()
+	s4 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s3.Add(1)
@@ -498,8 +523,8 @@ func TestOrderedSetIsSubset(t *testing.T) {
 
 func TestOrderedSetIsDisjoint(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -526,8 +551,8 @@ func TestOrderedSetIsDisjoint(t *testing.T) {
 
 func TestOrderedSetIsProperSuperset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -553,8 +578,8 @@ func TestOrderedSetIsProperSuperset(t *testing.T) {
 
 func TestOrderedSetIsProperSubset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -584,8 +609,8 @@ func TestOrderedSetIsProperSubset(t *testing.T) {
 
 func TestOrderedSetDisjointUnion(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -616,7 +641,7 @@ func TestOrderedSetDisjointUnion(t *testing.T) {
 
 func TestOrderedSetIsEmpty(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Test if the set is empty
 	if !s.IsEmpty() {
@@ -633,7 +658,7 @@ func TestOrderedSetIsEmpty(t *testing.T) {
 }
 func TestOrderedSetPop(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -652,14 +677,14 @@ func TestOrderedSetPop(t *testing.T) {
 
 func TestOrderedSetPopEmptySet(t *testing.T) {
 	// Create a new instance of the OrderedSet struct
-	s := NewOrderedSet()
+	s := NewOrderedSet[int]()
 
 	// Test the Pop method on an empty set
 	poppedItem := s.Pop()
 
-	// Test if the popped item is nil
-	if poppedItem != nil {
-		t.Errorf("Expected popped item to be nil")
+	// Test if the popped item is the zero value
+	if poppedItem != 0 {
+		t.Errorf("Expected popped item to be 0")
 	}
 
 	// Test if the length of the set is still 0
@@ -670,8 +695,8 @@ func TestOrderedSetPopEmptySet(t *testing.T) {
 }
 func TestOrderedSetIsEqual(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -691,8 +716,8 @@ func TestOrderedSetIsEqual(t *testing.T) {
 
 func TestOrderedSetIsEqualDifferentOrder(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -712,8 +737,8 @@ func TestOrderedSetIsEqualDifferentOrder(t *testing.T) {
 
 func TestOrderedSetIsEqualDifferentElements(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -732,8 +757,8 @@ func TestOrderedSetIsEqualDifferentElements(t *testing.T) {
 }
 func TestOrderedSetIsStrictSubset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -754,8 +779,8 @@ func TestOrderedSetIsStrictSubset(t *testing.T) {
 
 func TestOrderedSetIsStrictSubset_NotStrictSubset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -774,8 +799,8 @@ func TestOrderedSetIsStrictSubset_NotStrictSubset(t *testing.T) {
 }
 func TestOrderedSetIsStrictSuperset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -795,8 +820,8 @@ func TestOrderedSetIsStrictSuperset(t *testing.T) {
 
 func TestOrderedSetIsStrictSuperset_EmptySet(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	s1.Add(1)
 	s1.Add(2)
@@ -813,8 +838,8 @@ func TestOrderedSetIsStrictSuperset_EmptySet(t *testing.T) {
 
 func TestOrderedSetIsStrictSuperset_NotSuperset(t *testing.T) {
 	// Create two new instances of the OrderedSet struct
-	s1 := NewOrderedSet()
-	s2 := NewOrderedSet()
+	s1 := NewOrderedSet[int]()
+	s2 := NewOrderedSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -831,3 +856,509 @@ func TestOrderedSetIsStrictSuperset_NotSuperset(t *testing.T) {
 		t.Errorf("Expected first set to not be a strict superset of the second set")
 	}
 }
+
+// user is a struct that is compared by id in tests.
+type user struct {
+	id   int
+	name string
+}
+
+func TestOrderedSetWithEqual(t *testing.T) {
+	// Create a new OrderedSet that compares users by id
+	byID := func(a, b user) bool { return a.id == b.id }
+	s := NewOrderedSet(WithEqual(byID))
+	s.Add(user{1, "alice"})
+	s.Add(user{2, "bob"})
+	s.Add(user{1, "alicia"})
+
+	// Test that users with the same id are not added twice
+	if s.Len() != 2 || s.Get(0).name != "alice" {
+		t.Errorf("Expected [alice bob], got %v", s)
+	}
+	if !s.Contains(user{2, ""}) {
+		t.Errorf("Expected set to contain user 2")
+	}
+
+	// Test that derived sets use the same equality function
+	other := NewOrderedSet(WithEqual(byID))
+	other.Add(user{2, "robert"})
+	other.Add(user{3, "carol"})
+	if union := s.Union(other); union.Len() != 3 {
+		t.Errorf("Expected union length to be 3, got %d", union.Len())
+	}
+
+	// Test removing by id
+	s.Remove(user{1, ""})
+	if s.Len() != 1 || s.Contains(user{1, "alice"}) {
+		t.Errorf("Expected user 1 to be removed")
+	}
+}
+
+func TestOrderedSetFuncs(t *testing.T) {
+	// Create a new OrderedSet
+	s := NewOrderedSet[int]()
+	for i := 1; i <= 6; i++ {
+		s.Add(i)
+	}
+	even := func(element int) bool { return element%2 == 0 }
+
+	// Test ContainsFunc and IndexFunc
+	if !s.ContainsFunc(even) || s.IndexFunc(even) != 1 {
+		t.Errorf("Expected the first even element at index 1, got %d", s.IndexFunc(even))
+	}
+
+	// Test RemoveFunc and RemoveAllFunc
+	if !s.RemoveFunc(even) || s.Contains(2) {
+		t.Errorf("Expected 2 to be removed")
+	}
+	if removed := s.RemoveAllFunc(even); removed != 2 {
+		t.Errorf("Expected 2 elements to be removed, got %d", removed)
+	}
+	if s.ContainsFunc(even) || s.RemoveFunc(even) || s.Len() != 3 {
+		t.Errorf("Expected [1 3 5], got %v", s)
+	}
+}
+
+func TestOrderedSetInPlaceOperations(t *testing.T) {
+	// Test the in-place operations with several other sets, and the order of the result
+	newSet := func(items ...int) *OrderedSet[int] {
+		s := NewOrderedSet[int]()
+		for _, item := range items {
+			s.Add(item)
+		}
+		return s
+	}
+	other1, other2 := newSet(4, 3, 2), newSet(5, 4, 3)
+	tests := []struct {
+		name     string
+		apply    func(s *OrderedSet[int])
+		expected []int
+	}{
+		{"UnionWith", func(s *OrderedSet[int]) { s.UnionWith(other1, other2) }, []int{1, 2, 3, 4, 5}},
+		{"IntersectWith", func(s *OrderedSet[int]) { s.IntersectWith(other1, other2) }, []int{3}},
+		{"DifferenceWith", func(s *OrderedSet[int]) { s.DifferenceWith(other1, other2) }, []int{1}},
+		{"SymmetricDifferenceWith", func(s *OrderedSet[int]) { s.SymmetricDifferenceWith(other1, other2) }, []int{1, 5, 3}},
+		{"UnionWith itself", func(s *OrderedSet[int]) { s.UnionWith(s) }, []int{1, 2, 3}},
+		{"SymmetricDifferenceWith itself", func(s *OrderedSet[int]) { s.SymmetricDifferenceWith(s) }, []int{}},
+	}
+	for _, test := range tests {
+		s := newSet(1, 2, 3)
+		test.apply(s)
+		if !slices.Equal(s.Values(), test.expected) {
+			t.Errorf("Expected %v after %s, got %v", test.expected, test.name, s.Values())
+		}
+	}
+
+	// Test that a union into an empty set keeps the order of the sets
+	union := NewOrderedSet[int]()
+	union.UnionWith(other2, other1)
+	if expected := []int{5, 4, 3, 2}; !slices.Equal(union.Values(), expected) {
+		t.Errorf("Expected %v, got %v", expected, union.Values())
+	}
+}
+
+// newOrderedSetOf returns an OrderedSet holding the items in order.
+func newOrderedSetOf(items ...int) *OrderedSet[int] {
+	s := NewOrderedSet[int]()
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+func TestOrderedSetInPlaceOperationsEvents(t *testing.T) {
+	// Test that an in-place operation is delivered as a single notification: a Batch event for several changes,
+	// the event itself for one change, and nothing when the set does not change
+	tests := []struct {
+		name   string
+		apply  func(s *OrderedSet[int])
+		kinds  []collections.EventKind
+		events int
+	}{
+		{"several changes", func(s *OrderedSet[int]) { s.UnionWith(newOrderedSetOf(4, 5)) }, []collections.EventKind{collections.Batch}, 2},
+		{"one change", func(s *OrderedSet[int]) { s.IntersectWith(newOrderedSetOf(1, 2)) }, []collections.EventKind{collections.Removed}, 0},
+		{"no change", func(s *OrderedSet[int]) { s.DifferenceWith(newOrderedSetOf(4)) }, nil, 0},
+	}
+	for _, test := range tests {
+		s := newOrderedSetOf(1, 2, 3)
+		var kinds []collections.EventKind
+		events := 0
+		s.Subscribe(func(event collections.Event[int]) {
+			kinds = append(kinds, event.Kind)
+			events += len(event.Events)
+		})
+		test.apply(s)
+		if !slices.Equal(kinds, test.kinds) || events != test.events {
+			t.Errorf("Expected %v with %d batched events for %s, got %v with %d", test.kinds, test.events, test.name, kinds, events)
+		}
+	}
+}
+
+func TestOrderedSetSubscribeSeesFinalState(t *testing.T) {
+	// Test that a subscriber reading the set during a notification sees it after the whole change
+	s := newOrderedSetOf(1, 2, 3, 4, 5)
+	var seen []int
+	seenLen := -1
+	s.Subscribe(func(collections.Event[int]) {
+		seen = s.Values()
+		seenLen = s.Len()
+	})
+	s.RemoveAllFunc(func(element int) bool { return element%2 == 0 })
+	if expected := []int{1, 3, 5}; !slices.Equal(seen, expected) || seenLen != 3 {
+		t.Errorf("Expected the subscriber to see %v, got %v with Len %d", expected, seen, seenLen)
+	}
+	s.IntersectWith(newOrderedSetOf(5, 1))
+	if expected := []int{1, 5}; !slices.Equal(seen, expected) || seenLen != 2 {
+		t.Errorf("Expected the subscriber to see %v, got %v with Len %d", expected, seen, seenLen)
+	}
+}
+
+func TestUnionAllOrdered(t *testing.T) {
+	// Test that the union keeps the order in which the elements first appear
+	tests := []struct {
+		sets     [][]int
+		expected []int
+	}{
+		{[][]int{{3, 1}, {2, 1, 4}, {5, 3}}, []int{3, 1, 2, 4, 5}},
+		{[][]int{{2, 1}}, []int{2, 1}},
+		{nil, []int{}},
+	}
+	for _, test := range tests {
+		var sets []*OrderedSet[int]
+		for _, items := range test.sets {
+			sets = append(sets, newOrderedSetOf(items...))
+		}
+		union := UnionAllOrdered(sets...)
+		if !slices.Equal(union.Values(), test.expected) {
+			t.Errorf("Expected %v, got %v", test.expected, union.Values())
+		}
+		if len(sets) > 0 && union == sets[0] {
+			t.Errorf("Expected a new set")
+		}
+	}
+}
+
+func TestIntersectAllOrdered(t *testing.T) {
+	// Test that the intersection keeps the order of the smallest set
+	tests := []struct {
+		sets     [][]int
+		expected []int
+	}{
+		{[][]int{{1, 2, 3, 4, 5}, {5, 4, 3}, {3, 4, 5, 6}}, []int{5, 4, 3}},
+		{[][]int{{4, 3, 2}, {2, 3, 4}}, []int{4, 3, 2}},
+		{[][]int{{1, 2}, {3}}, []int{}},
+		{[][]int{{2, 1}}, []int{2, 1}},
+		{nil, []int{}},
+	}
+	for _, test := range tests {
+		var sets []*OrderedSet[int]
+		for _, items := range test.sets {
+			sets = append(sets, newOrderedSetOf(items...))
+		}
+		if intersection := IntersectAllOrdered(sets...); !slices.Equal(intersection.Values(), test.expected) {
+			t.Errorf("Expected %v, got %v", test.expected, intersection.Values())
+		}
+	}
+
+	// Test that the result compares elements like the smallest set
+	byLength := WithEqual(func(a, b string) bool { return len(a) == len(b) })
+	s1, s2 := NewOrderedSet(byLength), NewOrderedSet[string]()
+	s1.Add("ab")
+	s2.Add("ab")
+	s2.Add("cd")
+	intersection := IntersectAllOrdered(s2, s1)
+	if !intersection.Contains("xy") {
+		t.Errorf("Expected the intersection to compare elements by length")
+	}
+}
+
+func TestOrderedSetSubscribe(t *testing.T) {
+	// Test that replaying the events of every change reproduces the set in order
+	s := NewOrderedSet[int]()
+	var replayed []int
+	notifications := 0
+	unsubscribe := s.Subscribe(func(event collections.Event[int]) {
+		replayed = testkit.Replay(replayed, event)
+		notifications++
+	})
+	check := func(operation string) {
+		t.Helper()
+		if !slices.Equal(replayed, s.Values()) {
+			t.Errorf("Expected events to reproduce %v after %s, got %v", s.Values(), operation, replayed)
+		}
+	}
+
+	for _, item := range []int{1, 2, 3, 4, 5, 6} {
+		s.Add(item)
+	}
+	s.Add(1)
+	check("Add")
+	if notifications != 6 {
+		t.Errorf("Expected 6 notifications, got %d", notifications)
+	}
+	s.Remove(3)
+	check("Remove")
+	s.Pop()
+	check("Pop")
+
+	// Test that a bulk operation is delivered as a single notification
+	notifications = 0
+	s.RemoveAllFunc(func(element int) bool { return element%2 == 0 })
+	check("RemoveAllFunc")
+	if notifications != 1 {
+		t.Errorf("Expected 1 notification for RemoveAllFunc, got %d", notifications)
+	}
+	s.Clear()
+	check("Clear")
+
+	unsubscribe()
+	notifications = 0
+	s.Add(1)
+	if notifications != 0 {
+		t.Errorf("Expected no notifications after unsubscribing, got %d", notifications)
+	}
+}
+
+func TestOrderedSetConformance(t *testing.T) {
+	// Test that OrderedSet behaves like every other SetLike, and keeps insertion order
+	testkit.TestSet(t, func() collections.SetLike[string] { return NewOrderedSet[string]() },
+		func(i int) string { return fmt.Sprint("item", i) },
+		testkit.Ordered[string](),
+		testkit.WithClone(func(c collections.Collection[string]) collections.Collection[string] {
+			return c.(*OrderedSet[string]).Clone()
+		}))
+
+	// Test that Contains uses the equality function of the set
+	testkit.TestSet(t, func() collections.SetLike[string] { return NewOrderedSet(WithEqual(strings.EqualFold)) },
+		func(i int) string { return fmt.Sprint("item", i) },
+		testkit.Ordered[string](),
+		testkit.WithEquivalent(func(i int) string { return fmt.Sprint("ITEM", i) }))
+}
+
+func FuzzOrderedSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 4, 2, 4, 5, 5, 0, 6, 0, 7, 0, 8, 0, 1, 1, 2, 1})
+	f.Add([]byte{0, 3, 0, 1, 0, 3, 3, 0, 2, 0, 4, 1, 8, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to two OrderedSets and to two slices, and compare them after every operation
+		s, other := NewOrderedSet[int](), NewOrderedSet[int]()
+		var model, otherModel []int
+		// filter returns the elements of values for which keep returns true, in order.
+		filter := func(values []int, keep func(v int) bool) []int {
+			var kept []int
+			for _, v := range values {
+				if keep(v) {
+					kept = append(kept, v)
+				}
+			}
+			return kept
+		}
+		inOther := func(v int) bool { return slices.Contains(otherModel, v) }
+		inModel := func(v int) bool { return slices.Contains(model, v) }
+		for _, op := range testkit.DecodeOps(data, 9) {
+			index := op.Arg % (len(model) + 2)
+			var expected []int
+			var result, inPlace *OrderedSet[int]
+			switch op.Code {
+			case 0:
+				s.Add(op.Arg)
+				if !inModel(op.Arg) {
+					model = append(model, op.Arg)
+				}
+			case 1:
+				s.Remove(op.Arg)
+				if i := slices.Index(model, op.Arg); i >= 0 {
+					model = slices.Delete(model, i, i+1)
+				}
+			case 2:
+				expected := 0
+				if index >= 0 && index < len(model) {
+					expected = model[index]
+				}
+				if got := s.Get(index); got != expected {
+					t.Fatalf("Expected Get(%d) on %v to be %v, got %v", index, model, expected, got)
+				}
+			case 3:
+				expected := 0
+				if len(model) > 0 {
+					expected = model[0]
+				}
+				if got := s.Pop(); got != expected {
+					t.Fatalf("Expected Pop on %v to be %v, got %v", model, expected, got)
+				}
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			case 4:
+				other.Add(op.Arg)
+				if !inOther(op.Arg) {
+					otherModel = append(otherModel, op.Arg)
+				}
+			case 5:
+				expected = append(slices.Clone(model), filter(otherModel, func(v int) bool { return !inModel(v) })...)
+				result = s.Union(other)
+				inPlace = s.Clone()
+				inPlace.UnionWith(other)
+			case 6:
+				expected = filter(model, inOther)
+				result = s.Intersection(other)
+				inPlace = s.Clone()
+				inPlace.IntersectWith(other)
+			case 7:
+				expected = filter(model, func(v int) bool { return !inOther(v) })
+				result = s.Difference(other)
+				inPlace = s.Clone()
+				inPlace.DifferenceWith(other)
+			case 8:
+				expected = append(filter(model, func(v int) bool { return !inOther(v) }),
+					filter(otherModel, func(v int) bool { return !inModel(v) })...)
+				result = s.SymmetricDifference(other)
+				inPlace = s.Clone()
+				inPlace.SymmetricDifferenceWith(other)
+			}
+			if result != nil && !slices.Equal(result.Values(), expected) {
+				t.Fatalf("Expected %v for operation %d on %v and %v, got %v", expected, op.Code, model, otherModel, result.Values())
+			}
+			if inPlace != nil && !slices.Equal(inPlace.Values(), expected) {
+				t.Fatalf("Expected %v for the in-place operation %d on %v and %v, got %v", expected, op.Code, model, otherModel, inPlace.Values())
+			}
+			if !slices.Equal(s.Values(), model) || !slices.Equal(other.Values(), otherModel) {
+				t.Fatalf("Expected %v and %v after %+v, got %v and %v", model, otherModel, op, s.Values(), other.Values())
+			}
+		}
+	})
+}
+
+// newBenchOrderedSet returns an ordered set holding the integers from 0 to n-1.
+// It fills the elements directly, because adding them one by one takes quadratic time.
+func newBenchOrderedSet(n int) *OrderedSet[int] {
+	s := NewOrderedSet[int]()
+	for i := 0; i < n; i++ {
+		s.elements = append(s.elements, i)
+	}
+	return s
+}
+
+// orderedMap is the usual standard library alternative to an ordered set: a slice for the order
+// and a map for membership.
+type orderedMap struct {
+	order []int
+	index map[int]struct{}
+}
+
+// newBenchOrderedMap returns an orderedMap holding the integers from 0 to n-1.
+func newBenchOrderedMap(n int) *orderedMap {
+	return &orderedMap{order: newBenchSlice(n), index: newBenchMap(n)}
+}
+
+// newBenchSlice returns a slice holding the integers from 0 to n-1.
+func newBenchSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func BenchmarkOrderedSetAddRemove(b *testing.B) {
+	testkit.Bench(b, "OrderedSet", func(b *testing.B, n int) {
+		s := newBenchOrderedSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s.Add(n + i)
+			s.Remove(n + i)
+		}
+	})
+	testkit.Bench(b, "slice+map", func(b *testing.B, n int) {
+		m := newBenchOrderedMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, ok := m.index[n+i]; !ok {
+				m.index[n+i] = struct{}{}
+				m.order = append(m.order, n+i)
+			}
+			delete(m.index, n+i)
+			j := slices.Index(m.order, n+i)
+			m.order = slices.Delete(m.order, j, j+1)
+		}
+	})
+}
+
+func BenchmarkOrderedSetContains(b *testing.B) {
+	testkit.Bench(b, "OrderedSet", func(b *testing.B, n int) {
+		s := newBenchOrderedSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s.Contains(i % n)
+		}
+	})
+	testkit.Bench(b, "slice+map", func(b *testing.B, n int) {
+		m := newBenchOrderedMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, benchSink = m.index[i%n]
+		}
+	})
+}
+
+func BenchmarkOrderedSetGet(b *testing.B) {
+	testkit.Bench(b, "OrderedSet", func(b *testing.B, n int) {
+		s := newBenchOrderedSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s.Get(i % n)
+		}
+	})
+	testkit.Bench(b, "slice+map", func(b *testing.B, n int) {
+		m := newBenchOrderedMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = m.order[i%n]
+		}
+	})
+}
+
+func BenchmarkOrderedSetUnion(b *testing.B) {
+	testkit.Bench(b, "OrderedSet", func(b *testing.B, n int) {
+		if n > 10_000 {
+			b.Skip("Union takes quadratic time")
+		}
+		s1, s2 := newBenchOrderedSet(n), newBenchOrderedSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s1.Union(s2)
+		}
+	})
+	testkit.Bench(b, "slice+map", func(b *testing.B, n int) {
+		m1, m2 := newBenchOrderedMap(n), newBenchOrderedMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			union := &orderedMap{index: make(map[int]struct{})}
+			for _, m := range []*orderedMap{m1, m2} {
+				for _, v := range m.order {
+					if _, ok := union.index[v]; !ok {
+						union.index[v] = struct{}{}
+						union.order = append(union.order, v)
+					}
+				}
+			}
+			benchSink = union
+		}
+	})
+}
+
+func BenchmarkOrderedSetValues(b *testing.B) {
+	testkit.Bench(b, "OrderedSet", func(b *testing.B, n int) {
+		s := newBenchOrderedSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s.Values()
+		}
+	})
+	testkit.Bench(b, "slice+map", func(b *testing.B, n int) {
+		m := newBenchOrderedMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = slices.Clone(m.order)
+		}
+	})
+}
@@ -1,43 +1,98 @@
 package set
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
 
-type Set struct {
-	elements map[interface{}]bool
+	"goCollections/collections"
+)
+
+// Set is an unordered collection of unique values of type T.
+type Set[T comparable] struct {
+	elements  map[T]struct{}
+	modCount  int                      // Incremented on every change, so transactions can detect changes made outside of them.
+	observers collections.Observers[T] // The subscribers to changes of the set.
 }
 
+var _ collections.SetLike[int] = (*Set[int])(nil)
+
 // Add adds an item to the set.
 // The item parameter is the value to be added to the set.
-func (s *Set) Add(item interface{}) {
-	s.elements[item] = true
+func (s *Set[T]) Add(item T) {
+	n := len(s.elements)
+	s.elements[item] = struct{}{}
+	if len(s.elements) == n {
+		return
+	}
+	s.modCount++
+	s.observers.Emit(collections.Event[T]{Kind: collections.Added, Index: -1, Value: item})
 }
 
 // Remove removes the specified item from the set.
-func (s *Set) Remove(item interface{}) {
+func (s *Set[T]) Remove(item T) {
+	n := len(s.elements)
 	delete(s.elements, item)
+	if len(s.elements) == n {
+		return
+	}
+	s.modCount++
+	s.observers.Emit(collections.Event[T]{Kind: collections.Removed, Index: -1, Value: item})
 }
 
 // Contains checks if the Set contains the specified item.
 // It returns true if the item is found in the Set, otherwise it returns false.
-func (s *Set) Contains(item interface{}) bool {
-	return s.elements[item]
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.elements[item]
+	return ok
+}
+
+// ContainsFunc checks if the Set contains an item for which match returns true.
+func (s *Set[T]) ContainsFunc(match func(item T) bool) bool {
+	for key := range s.elements {
+		if match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAllFunc removes every item for which match returns true.
+// It returns the number of removed items.
+func (s *Set[T]) RemoveAllFunc(match func(item T) bool) int {
+	removed := 0
+	s.observers.Batch(func() {
+		for key := range s.elements {
+			if match(key) {
+				delete(s.elements, key)
+				removed++
+				s.modCount++
+				s.observers.Emit(collections.Event[T]{Kind: collections.Removed, Index: -1, Value: key})
+			}
+		}
+	})
+	return removed
 }
 
 // Len returns the number of elements in the set.
 // It returns an integer representing the size of the set.
-func (s *Set) Len() int {
+func (s *Set[T]) Len() int {
 	return len(s.elements)
 }
 
 // Clear removes all elements from the set.
-func (s *Set) Clear() {
-	s.elements = make(map[interface{}]bool)
+func (s *Set[T]) Clear() {
+	if len(s.elements) == 0 {
+		return
+	}
+	s.elements = make(map[T]struct{})
+	s.modCount++
+	s.observers.Emit(collections.Event[T]{Kind: collections.Cleared, Index: -1})
 }
 
 // Equal checks if the current set is equal to another set.
 // It returns true if the sets have the same length and contain the same elements,
 // otherwise it returns false.
-func (s *Set) Equal(other *Set) bool {
+func (s *Set[T]) Equal(other *Set[T]) bool {
 	if s.Len() != other.Len() {
 		return false
 	}
@@ -49,42 +104,65 @@ func (s *Set) Equal(other *Set) bool {
 	return true
 }
 
+// Values returns a slice containing the elements of the set. It is the same as ToSlice.
+func (s *Set[T]) Values() []T {
+	return s.ToSlice()
+}
+
 // ToSlice returns a slice containing all the elements in the set.
 // The order of the elements in the slice is not guaranteed.
-func (s *Set) ToSlice() []interface{} {
-	slice := make([]interface{}, 0, s.Len())
+func (s *Set[T]) ToSlice() []T {
+	slice := make([]T, 0, s.Len())
 	for key := range s.elements {
 		slice = append(slice, key)
 	}
 	return slice
 }
 
+// SortedSlice returns a slice containing all the elements in the set, sorted with the less function.
+func (s *Set[T]) SortedSlice(less func(a, b T) bool) []T {
+	slice := s.ToSlice()
+	slices.SortFunc(slice, func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+	return slice
+}
+
 // FromSlice adds all the elements from the given slice to the set.
 // It iterates over the slice and calls the Add method to add each element to the set.
-func (s *Set) FromSlice(slice []interface{}) {
-	for _, item := range slice {
-		s.Add(item)
-	}
+// The additions are delivered to subscribers as a single batch.
+func (s *Set[T]) FromSlice(slice []T) {
+	s.observers.Batch(func() {
+		for _, item := range slice {
+			s.Add(item)
+		}
+	})
 }
 
 // NewSet creates and returns a new Set.
-func NewSet() *Set {
-	return &Set{make(map[interface{}]bool)}
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{elements: make(map[T]struct{})}
 }
 
-// NewSetFromSlice creates a new Set from a given slice of interface{} values.
+// NewSetFromSlice creates a new Set from a given slice of values.
 // It takes a slice as input and returns a pointer to the newly created Set.
 // The elements of the slice are added to the Set using the FromSlice method.
-func NewSetFromSlice(slice []interface{}) *Set {
-	set := NewSet()
+func NewSetFromSlice[T comparable](slice []T) *Set[T] {
+	set := NewSet[T]()
 	set.FromSlice(slice)
 	return set
 }
 
 // Union returns a new Set that contains all the elements from both s1 and s2.
 // The original sets s1 and s2 are not modified.
-func Union(s1, s2 *Set) *Set {
-	s := NewSet()
+func Union[T comparable](s1, s2 *Set[T]) *Set[T] {
+	s := NewSet[T]()
 	for key := range s1.elements {
 		s.Add(key)
 	}
@@ -94,12 +172,69 @@ func Union(s1, s2 *Set) *Set {
 	return s
 }
 
+// UnionAll returns a new Set that contains all the elements of every given set.
+// It builds the result in one pass, without the intermediate sets of repeated calls to Union.
+func UnionAll[T comparable](sets ...*Set[T]) *Set[T] {
+	size := 0
+	for _, set := range sets {
+		size = max(size, set.Len())
+	}
+	union := &Set[T]{elements: make(map[T]struct{}, size)}
+	for _, set := range sets {
+		for key := range set.elements {
+			union.elements[key] = struct{}{}
+		}
+	}
+	return union
+}
+
+// IntersectAll returns a new Set that contains the elements present in every given set,
+// or an empty Set if no sets are given. It only iterates over the smallest set.
+func IntersectAll[T comparable](sets ...*Set[T]) *Set[T] {
+	intersection := NewSet[T]()
+	if len(sets) == 0 {
+		return intersection
+	}
+	smallest := sets[0]
+	for _, set := range sets[1:] {
+		if set.Len() < smallest.Len() {
+			smallest = set
+		}
+	}
+	for key := range smallest.elements {
+		if containsAll(sets, key) {
+			intersection.elements[key] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// containsAll checks if every set in sets contains item.
+func containsAll[T comparable](sets []*Set[T], item T) bool {
+	for _, set := range sets {
+		if !set.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAny checks if any set in sets contains item.
+func containsAny[T comparable](sets []*Set[T], item T) bool {
+	for _, set := range sets {
+		if set.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
 // Intersection returns a new Set that contains the intersection of two Sets, s1 and s2.
 // It iterates over the elements in s1 and checks if each element is present in s2.
 // If an element is found in both s1 and s2, it is added to the new Set.
 // The resulting Set is then returned.
-func Intersection(s1, s2 *Set) *Set {
-	s := NewSet()
+func Intersection[T comparable](s1, s2 *Set[T]) *Set[T] {
+	s := NewSet[T]()
 	for key := range s1.elements {
 		if s2.Contains(key) {
 			s.Add(key)
@@ -112,8 +247,8 @@ func Intersection(s1, s2 *Set) *Set {
 // The function iterates over the elements in s1 and checks if each element is present in s2.
 // If an element is not found in s2, it is added to the new Set.
 // The returned Set does not modify the original Sets s1 and s2.
-func Difference(s1, s2 *Set) *Set {
-	s := NewSet()
+func Difference[T comparable](s1, s2 *Set[T]) *Set[T] {
+	s := NewSet[T]()
 	for key := range s1.elements {
 		if !s2.Contains(key) {
 			s.Add(key)
@@ -130,8 +265,8 @@ func Difference(s1, s2 *Set) *Set {
 // Then, the function iterates over the elements of s2 and checks if each element is present in s1.
 // If an element is not present in s1, it is added to the new Set object.
 // Finally, the function returns the new Set object.
-func SymmetricDifference(s1, s2 *Set) *Set {
-	s := NewSet()
+func SymmetricDifference[T comparable](s1, s2 *Set[T]) *Set[T] {
+	s := NewSet[T]()
 	for key := range s1.elements {
 		if !s2.Contains(key) {
 			s.Add(key)
@@ -149,7 +284,7 @@ func SymmetricDifference(s1, s2 *Set) *Set {
 // It iterates over the elements in s1 and checks if each element is present in s2.
 // If any element in s1 is not found in s2, it returns false.
 // If all elements in s1 are found in s2, it returns true.
-func IsSubset(s1, s2 *Set) bool {
+func IsSubset[T comparable](s1, s2 *Set[T]) bool {
 	for key := range s1.elements {
 		if !s2.Contains(key) {
 			return false
@@ -160,13 +295,13 @@ func IsSubset(s1, s2 *Set) bool {
 
 // IsSuperset checks if s1 is a superset of s2.
 // It returns true if s1 contains all the elements of s2, false otherwise.
-func IsSuperset(s1, s2 *Set) bool {
+func IsSuperset[T comparable](s1, s2 *Set[T]) bool {
 	return IsSubset(s2, s1)
 }
 
 // IsDisjoint checks if two sets are disjoint.
 // It returns true if there are no common elements between the two sets, otherwise it returns false.
-func IsDisjoint(s1, s2 *Set) bool {
+func IsDisjoint[T comparable](s1, s2 *Set[T]) bool {
 	for key := range s1.elements {
 		if s2.Contains(key) {
 			return false
@@ -177,45 +312,46 @@ func IsDisjoint(s1, s2 *Set) bool {
 
 // Clone creates a new Set that is a copy of the given Set.
 // It returns a pointer to the new Set.
-func Clone(s *Set) *Set {
+func Clone[T comparable](s *Set[T]) *Set[T] {
 	return NewSetFromSlice(s.ToSlice())
 }
 
 // Equal checks if two sets are equal.
 // It returns true if the sets are equal, and false otherwise.
-func Equal(s1, s2 *Set) bool {
+func Equal[T comparable](s1, s2 *Set[T]) bool {
 	return s1.Equal(s2)
 }
 
 // String returns a string representation of the Set.
-// It converts the Set into a slice and formats it using the fmt.Sprintf function.
-func (s *Set) String() string {
-	return fmt.Sprintf("%v", s.ToSlice())
+// The elements are sorted, so that equal sets have the same representation: booleans, numbers and
+// strings by value, and other elements by their fmt representation.
+func (s *Set[T]) String() string {
+	return fmt.Sprintf("%v", s.sortedValues())
 }
 
 // IsEmpty checks if the Set is empty.
 // It returns true if the Set is empty, otherwise it returns false.
-func (s *Set) IsEmpty() bool {
+func (s *Set[T]) IsEmpty() bool {
 	return s.Len() == 0
 }
 
 // IsProperSubset checks if s1 is a proper subset of s2.
 // It returns true if s1 is a proper subset of s2, otherwise it returns false.
-func IsProperSubset(s1, s2 *Set) bool {
+func IsProperSubset[T comparable](s1, s2 *Set[T]) bool {
 	return s1.Len() < s2.Len() && IsSubset(s1, s2)
 }
 
 // IsProperSuperset checks if s1 is a proper superset of s2.
 // It returns true if s1 is a proper superset of s2, otherwise it returns false.
-func IsProperSuperset(s1, s2 *Set) bool {
+func IsProperSuperset[T comparable](s1, s2 *Set[T]) bool {
 	return s1.Len() > s2.Len() && IsSuperset(s1, s2)
 }
 
 // PowerSet returns the power set of the given Set.
 // It returns a slice of Sets, where each Set is a subset of the original Set.
-func PowerSet(s *Set) []*Set {
-	powerSet := make([]*Set, 0)
-	powerSet = append(powerSet, NewSet())
+func PowerSet[T comparable](s *Set[T]) []*Set[T] {
+	powerSet := make([]*Set[T], 0)
+	powerSet = append(powerSet, NewSet[T]())
 	for _, item := range s.ToSlice() {
 		for _, subset := range powerSet {
 			newSubset := Clone(subset)
@@ -228,36 +364,97 @@ func PowerSet(s *Set) []*Set {
 
 // CartesianProduct returns the Cartesian product of two Sets.
 // It returns a slice of Sets, where each Set is a pair of elements from the two Sets.
-func CartesianProduct(s1, s2 *Set) []*Set {
-	cartesianProduct := make([]*Set, 0)
+func CartesianProduct[T comparable](s1, s2 *Set[T]) []*Set[T] {
+	cartesianProduct := make([]*Set[T], 0)
 	for _, item1 := range s1.ToSlice() {
 		for _, item2 := range s2.ToSlice() {
-			cartesianProduct = append(cartesianProduct, NewSetFromSlice([]interface{}{item1, item2}))
+			cartesianProduct = append(cartesianProduct, NewSetFromSlice([]T{item1, item2}))
 		}
 	}
 	return cartesianProduct
 }
 
+// UnionWith adds the elements of the other sets to the set, in place.
+// Subscribers receive the changes as a single notification.
+func (s *Set[T]) UnionWith(others ...*Set[T]) {
+	s.observers.Batch(func() {
+		for _, other := range others {
+			if other == s {
+				continue
+			}
+			for key := range other.elements {
+				s.Add(key)
+			}
+		}
+	})
+}
+
+// IntersectWith removes the elements that are not in every other set from the set, in place.
+// Subscribers receive the changes as a single notification.
+func (s *Set[T]) IntersectWith(others ...*Set[T]) {
+	s.observers.Batch(func() {
+		for key := range s.elements {
+			if !containsAll(others, key) {
+				s.Remove(key)
+			}
+		}
+	})
+}
+
+// DifferenceWith removes the elements that are in any other set from the set, in place.
+// Subscribers receive the changes as a single notification.
+func (s *Set[T]) DifferenceWith(others ...*Set[T]) {
+	s.observers.Batch(func() {
+		for key := range s.elements {
+			if containsAny(others, key) {
+				s.Remove(key)
+			}
+		}
+	})
+}
+
+// SymmetricDifferenceWith replaces the set, in place, with its symmetric difference with each other set in turn:
+// the elements of another set are removed if the set contains them, and added otherwise.
+// The result holds the elements that are in an odd number of the sets.
+// Subscribers receive the changes as a single notification.
+func (s *Set[T]) SymmetricDifferenceWith(others ...*Set[T]) {
+	s.observers.Batch(func() {
+		for _, other := range others {
+			if other == s {
+				s.Clear()
+				continue
+			}
+			for key := range other.elements {
+				if s.Contains(key) {
+					s.Remove(key)
+				} else {
+					s.Add(key)
+				}
+			}
+		}
+	})
+}
+
 // Difference returns a new Set that contains the elements that are present in the receiver Set but not in the given Set s2.
 // The receiver Set remains unchanged.
-func (s *Set) Difference(s2 *Set) *Set {
+func (s *Set[T]) Difference(s2 *Set[T]) *Set[T] {
 	return Difference(s, s2)
 }
 
 // SymmetricDifference returns a new Set that contains the elements that are present in either the current Set or the given Set, but not in both.
 // The original Sets are not modified.
-func (s *Set) SymmetricDifference(s2 *Set) *Set {
+func (s *Set[T]) SymmetricDifference(s2 *Set[T]) *Set[T] {
 	return SymmetricDifference(s, s2)
 }
 
 // Union returns a new Set that contains all the elements from both the current Set and the given Set.
 // The original Sets are not modified.
-func (s *Set) Union(s2 *Set) *Set {
+func (s *Set[T]) Union(s2 *Set[T]) *Set[T] {
 	return Union(s, s2)
 }
 
 // IsSubset checks if the current Set is a subset of the given Set s2.
-func (s *Set) IsSubset(s2 *Set) bool {
+func (s *Set[T]) IsSubset(s2 *Set[T]) bool {
 	for element := range s.elements {
 		if !s2.Contains(element) {
 			return false
@@ -267,12 +464,12 @@ func (s *Set) IsSubset(s2 *Set) bool {
 }
 
 // IsSuperset checks if the current Set is a superset of the given Set s2.
-func (s *Set) IsSuperset(s2 *Set) bool {
+func (s *Set[T]) IsSuperset(s2 *Set[T]) bool {
 	return s2.IsSubset(s)
 }
 
 // IsDisjoint checks if the current Set and the given Set s2 are disjoint.
-func (s *Set) IsDisjoint(s2 *Set) bool {
+func (s *Set[T]) IsDisjoint(s2 *Set[T]) bool {
 	for element := range s.elements {
 		if s2.Contains(element) {
 			return false
@@ -282,8 +479,8 @@ func (s *Set) IsDisjoint(s2 *Set) bool {
 }
 
 // Clone creates a new Set that is a copy of the current Set.
-func (s *Set) Clone() *Set {
-	clone := NewSet()
+func (s *Set[T]) Clone() *Set[T] {
+	clone := NewSet[T]()
 	for element := range s.elements {
 		clone.Add(element)
 	}
@@ -291,19 +488,19 @@ func (s *Set) Clone() *Set {
 }
 
 // IsProperSubset checks if the current Set is a proper subset of the given Set s2.
-func (s *Set) IsProperSubset(s2 *Set) bool {
+func (s *Set[T]) IsProperSubset(s2 *Set[T]) bool {
 	return s.IsSubset(s2) && !s.Equal(s2)
 }
 
 // IsProperSuperset checks if the current Set is a proper superset of the given Set s2.
-func (s *Set) IsProperSuperset(s2 *Set) bool {
+func (s *Set[T]) IsProperSuperset(s2 *Set[T]) bool {
 	return s.IsSuperset(s2) && !s.Equal(s2)
 }
 
 // PowerSet returns the power set of the current Set.
-func (s *Set) PowerSet() []*Set {
-	powerSet := make([]*Set, 0)
-	powerSet = append(powerSet, NewSet())
+func (s *Set[T]) PowerSet() []*Set[T] {
+	powerSet := make([]*Set[T], 0)
+	powerSet = append(powerSet, NewSet[T]())
 	for _, item := range s.ToSlice() {
 		for _, subset := range powerSet {
 			newSubset := subset.Clone()
@@ -315,17 +512,83 @@ func (s *Set) PowerSet() []*Set {
 }
 
 // CartesianProduct returns the Cartesian product of the current Set and the given Set s2.
-func (s *Set) CartesianProduct(s2 *Set) []*Set {
-	cartesianProduct := make([]*Set, 0)
+func (s *Set[T]) CartesianProduct(s2 *Set[T]) []*Set[T] {
+	cartesianProduct := make([]*Set[T], 0)
 	for _, item1 := range s.ToSlice() {
 		for _, item2 := range s2.ToSlice() {
-			cartesianProduct = append(cartesianProduct, NewSetFromSlice([]interface{}{item1, item2}))
+			cartesianProduct = append(cartesianProduct, NewSetFromSlice([]T{item1, item2}))
 		}
 	}
 	return cartesianProduct
 }
 
 // Intersection returns a new Set that contains the intersection of the current Set and the given Set s2.
-func (s *Set) Intersection(s2 *Set) *Set {
+func (s *Set[T]) Intersection(s2 *Set[T]) *Set[T] {
 	return Intersection(s, s2)
 }
+
+// Subscribe registers fn to be called with an event after every change to the set, and returns
+// a function that unregisters it. The events of a set have an Index of -1. Bulk operations such as
+// FromSlice and RemoveAllFunc deliver their changes as a single Batch event.
+func (s *Set[T]) Subscribe(fn func(event collections.Event[T])) (unsubscribe func()) {
+	return s.observers.Subscribe(fn)
+}
+
+// IntersectionSize returns the number of elements that are in both the current Set and s2,
+// without building the intersection. It iterates over the smaller set.
+func (s *Set[T]) IntersectionSize(s2 *Set[T]) int {
+	small, large := s, s2
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	n := 0
+	for key := range small.elements {
+		if large.Contains(key) {
+			n++
+		}
+	}
+	return n
+}
+
+// UnionSize returns the number of elements that are in the current Set, s2 or both,
+// without building the union.
+func (s *Set[T]) UnionSize(s2 *Set[T]) int {
+	return s.Len() + s2.Len() - s.IntersectionSize(s2)
+}
+
+// Jaccard returns the Jaccard similarity of the current Set and s2: the size of their intersection
+// divided by the size of their union, between 0 for disjoint sets and 1 for equal sets.
+// It returns 1 if both sets are empty.
+func (s *Set[T]) Jaccard(s2 *Set[T]) float64 {
+	intersection := s.IntersectionSize(s2)
+	union := s.Len() + s2.Len() - intersection
+	if union == 0 {
+		return 1
+	}
+	return float64(intersection) / float64(union)
+}
+
+// Dice returns the Sørensen–Dice coefficient of the current Set and s2: twice the size of their
+// intersection divided by the sum of their sizes, between 0 for disjoint sets and 1 for equal sets.
+// It returns 1 if both sets are empty.
+func (s *Set[T]) Dice(s2 *Set[T]) float64 {
+	total := s.Len() + s2.Len()
+	if total == 0 {
+		return 1
+	}
+	return 2 * float64(s.IntersectionSize(s2)) / float64(total)
+}
+
+// Overlap returns the overlap coefficient of the current Set and s2: the size of their intersection
+// divided by the size of the smaller set, which is 1 if one set is a subset of the other.
+// It returns 1 if both sets are empty and 0 if only one is.
+func (s *Set[T]) Overlap(s2 *Set[T]) float64 {
+	smaller := min(s.Len(), s2.Len())
+	if smaller == 0 {
+		if s.Len() == s2.Len() {
+			return 1
+		}
+		return 0
+	}
+	return float64(s.IntersectionSize(s2)) / float64(smaller)
+}
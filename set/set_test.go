@@ -1,12 +1,17 @@
 package set
 
 import (
+	"fmt"
+	"slices"
 	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
 )
 
 func TestPowerSet(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -50,7 +55,7 @@ func TestPowerSet(t *testing.T) {
 
 func TestIsProperSuperset(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -58,7 +63,7 @@ func TestIsProperSuperset(t *testing.T) {
 	s.Add(3)
 
 	// Create another set
-	s2 := NewSet()
+	s2 := NewSet[int]()
 
 	// Add some elements to the second set
 	s2.Add(1)
@@ -76,7 +81,7 @@ func TestIsProperSuperset(t *testing.T) {
 	}
 
 	// Create another set
-	s3 := NewSet()
+	s3 := NewSet[int]()
 
 	// Add some elements to the third set
 	s3.Add(1)
@@ -96,8 +101,8 @@ func TestIsProperSuperset(t *testing.T) {
 }
 func TestIsProperSubset(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -141,7 +146,7 @@ func TestIsProperSubset(t *testing.T) {
 }
 func TestClone(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -169,8 +174,8 @@ func TestClone(t *testing.T) {
 }
 func TestIsDisjoint(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -209,7 +214,7 @@ func TestIsDisjoint(t *testing.T) {
 }
 func TestIsSuperset(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -217,7 +222,7 @@ func TestIsSuperset(t *testing.T) {
 	s.Add(3)
 
 	// Create another set
-	s2 := NewSet()
+	s2 := NewSet[int]()
 
 	// Add some elements to the second set
 	s2.Add(1)
@@ -236,7 +241,7 @@ func TestIsSuperset(t *testing.T) {
 	}
 
 	// Create another set
-	s3 := NewSet()
+	s3 := NewSet[int]()
 
 	// Add some elements to the third set
 	s3.Add(1)
@@ -252,7 +257,7 @@ func TestIsSuperset(t *testing.T) {
 
 func TestIsSubset(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -260,7 +265,7 @@ func TestIsSubset(t *testing.T) {
 	s.Add(3)
 
 	// Create another set
-	s2 := NewSet()
+	s2 := NewSet[int]()
 
 	// Add some elements to the second set
 	s2.Add(1)
@@ -279,7 +284,7 @@ func TestIsSubset(t *testing.T) {
 	}
 
 	// Create another set
-	s3 := NewSet()
+	s3 := NewSet[int]()
 
 	// Add some elements to the third set
 	s3.Add(1)
@@ -299,8 +304,8 @@ func TestIsSubset(t *testing.T) {
 
 func TestSymmetricDifference(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -316,7 +321,7 @@ func TestSymmetricDifference(t *testing.T) {
 	symDiff := s1.SymmetricDifference(s2)
 
 	// Test if the symmetric difference contains the correct elements
-	expectedElements := []interface{}{1, 4}
+	expectedElements := []int{1, 4}
 	for _, element := range expectedElements {
 		if !symDiff.Contains(element) {
 			t.Errorf("Expected symmetric difference to contain element %v", element)
@@ -326,8 +331,8 @@ func TestSymmetricDifference(t *testing.T) {
 
 func TestDifference(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -343,7 +348,7 @@ func TestDifference(t *testing.T) {
 	diff := s1.Difference(s2)
 
 	// Test if the difference contains the correct elements
-	expectedElements := []interface{}{1}
+	expectedElements := []int{1}
 	for _, element := range expectedElements {
 		if !diff.Contains(element) {
 			t.Errorf("Expected difference to contain element %v", element)
@@ -353,8 +358,8 @@ func TestDifference(t *testing.T) {
 
 func TestIntersection(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -370,7 +375,7 @@ func TestIntersection(t *testing.T) {
 	intersection := s1.Intersection(s2)
 
 	// Test if the intersection contains the correct elements
-	expectedElements := []interface{}{2, 3}
+	expectedElements := []int{2, 3}
 	for _, element := range expectedElements {
 		if !intersection.Contains(element) {
 			t.Errorf("Expected intersection to contain element %v", element)
@@ -380,8 +385,8 @@ func TestIntersection(t *testing.T) {
 
 func TestUnion(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -397,7 +402,7 @@ func TestUnion(t *testing.T) {
 	union := s1.Union(s2)
 
 	// Test if the union contains the correct elements
-	expectedElements := []interface{}{1, 2, 3, 4}
+	expectedElements := []int{1, 2, 3, 4}
 	for _, element := range expectedElements {
 		if !union.Contains(element) {
 			t.Errorf("Expected union to contain element %v", element)
@@ -407,7 +412,7 @@ func TestUnion(t *testing.T) {
 
 func TestRemove(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -418,7 +423,7 @@ func TestRemove(t *testing.T) {
 	s.Remove(2)
 
 	// Test if the set contains the correct elements
-	expectedElements := []interface{}{1, 3}
+	expectedElements := []int{1, 3}
 	for _, element := range expectedElements {
 		if !s.Contains(element) {
 			t.Errorf("Expected set to contain element %v", element)
@@ -428,7 +433,7 @@ func TestRemove(t *testing.T) {
 
 func TestContains(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -436,7 +441,7 @@ func TestContains(t *testing.T) {
 	s.Add(3)
 
 	// Test if the set contains the correct elements
-	expectedElements := []interface{}{1, 2, 3}
+	expectedElements := []int{1, 2, 3}
 	for _, element := range expectedElements {
 		if !s.Contains(element) {
 			t.Errorf("Expected set to contain element %v", element)
@@ -446,7 +451,7 @@ func TestContains(t *testing.T) {
 
 func TestLen(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -462,7 +467,7 @@ func TestLen(t *testing.T) {
 
 func TestClear(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -480,8 +485,8 @@ func TestClear(t *testing.T) {
 
 func TestEqual(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -502,7 +507,7 @@ func TestEqual(t *testing.T) {
 	}
 
 	// Create another set
-	s3 := NewSet()
+	s3 := NewSet[int]()
 
 	// Add some elements to the third set
 	s3.Add(1)
@@ -521,7 +526,7 @@ func TestEqual(t *testing.T) {
 func TestIsEmpty(t *testing.T) {
 
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Test if the set is empty
 	if !s.IsEmpty() {
@@ -541,7 +546,7 @@ func TestIsEmpty(t *testing.T) {
 
 func TestString(t *testing.T) {
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[string]()
 
 	// Add some elements to the set
 	s.Add("Hello")
@@ -553,10 +558,80 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestSortedSlice(t *testing.T) {
+	// Test that SortedSlice orders the elements with the less function
+	s := NewSetFromSlice([]int{3, 1, 4, 5, 9, 2, 6})
+	expected := []int{9, 6, 5, 4, 3, 2, 1}
+	if sorted := s.SortedSlice(func(a, b int) bool { return a > b }); !slices.Equal(sorted, expected) {
+		t.Errorf("Expected %v, got %v", expected, sorted)
+	}
+	if sorted := NewSet[int]().SortedSlice(func(a, b int) bool { return a < b }); len(sorted) != 0 {
+		t.Errorf("Expected an empty slice, got %v", sorted)
+	}
+}
+
+func TestStringDeterministic(t *testing.T) {
+	// Test that the string representation sorts the elements
+	type point struct{ X, Y int }
+	tests := []struct {
+		set      fmt.Stringer
+		expected string
+	}{
+		{NewSetFromSlice([]int{10, -2, 3, 1}), "[-2 1 3 10]"},
+		{NewSetFromSlice([]string{"pear", "apple", "fig"}), "[apple fig pear]"},
+		{NewSetFromSlice([]float64{2.5, -1, 0.5}), "[-1 0.5 2.5]"},
+		{NewSetFromSlice([]bool{true, false}), "[false true]"},
+		{NewSetFromSlice([]point{{2, 1}, {1, 2}, {1, 1}}), "[{1 1} {1 2} {2 1}]"},
+		{NewSetFromSlice([]interface{}{"b", 2, nil, "a", 1, true}), "[<nil> true 1 2 a b]"},
+		{NewSet[int](), "[]"},
+	}
+	for _, test := range tests {
+		// Compare several times, since map iteration order changes between calls
+		for i := 0; i < 10; i++ {
+			if str := test.set.String(); str != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, str)
+				break
+			}
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	// Test the fmt verbs supported by a set
+	s := NewSetFromSlice([]string{"b", "c", "a"})
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%v", "[a b c]"},
+		{"%s", "[a b c]"},
+		{"%+v", "{size:3 elements:[a b c]}"},
+		{"%#v", `set.NewSetFromSlice([]string{"a", "b", "c"})`},
+		{"%q", `["a" "b" "c"]`},
+		{"%3s", "[  a   b   c]"},
+	}
+	for _, test := range tests {
+		if str := fmt.Sprintf(test.format, s); str != test.expected {
+			t.Errorf("Expected %s for %s, got %s", test.expected, test.format, str)
+		}
+	}
+
+	// Test the Go syntax of numbers, the size of an empty set and an integer verb
+	if str := fmt.Sprintf("%#v", NewSetFromSlice([]int{2, 1})); str != "set.NewSetFromSlice([]int{1, 2})" {
+		t.Errorf("Expected set.NewSetFromSlice([]int{1, 2}), got %s", str)
+	}
+	if str := fmt.Sprintf("%+v", NewSet[int]()); str != "{size:0 elements:[]}" {
+		t.Errorf("Expected {size:0 elements:[]}, got %s", str)
+	}
+	if str := fmt.Sprintf("%d", NewSetFromSlice([]int{3, 1, 2})); str != "[1 2 3]" {
+		t.Errorf("Expected [1 2 3], got %s", str)
+	}
+}
+
 func TestToSlice(t *testing.T) {
 
 	// Create a new instance of the Set struct
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Add some elements to the set
 	s.Add(1)
@@ -564,7 +639,7 @@ func TestToSlice(t *testing.T) {
 	s.Add(3)
 
 	// Test the slice representation of the set
-	expectedSlice := []interface{}{1, 2, 3}
+	expectedSlice := []int{1, 2, 3}
 	for _, element := range expectedSlice {
 		if !s.Contains(element) {
 			t.Errorf("Expected set to contain element %v", element)
@@ -574,10 +649,10 @@ func TestToSlice(t *testing.T) {
 
 func TestNewSetFromSlice(t *testing.T) {
 	// Create a new set from a slice
-	s := NewSetFromSlice([]interface{}{1, 2, 3})
+	s := NewSetFromSlice([]int{1, 2, 3})
 
 	// Test if the set contains the correct elements
-	expectedElements := []interface{}{1, 2, 3}
+	expectedElements := []int{1, 2, 3}
 	for _, element := range expectedElements {
 		if !s.Contains(element) {
 			t.Errorf("Expected set to contain element %v", element)
@@ -587,7 +662,7 @@ func TestNewSetFromSlice(t *testing.T) {
 
 func TestNewSet(t *testing.T) {
 	// Create a new set
-	s := NewSet()
+	s := NewSet[int]()
 
 	// Test if the set is empty
 	if !s.IsEmpty() {
@@ -597,8 +672,8 @@ func TestNewSet(t *testing.T) {
 
 func TestCartesianProduct(t *testing.T) {
 	// Create two new instances of the Set struct
-	s1 := NewSet()
-	s2 := NewSet()
+	s1 := NewSet[int]()
+	s2 := NewSet[int]()
 
 	// Add some elements to the first set
 	s1.Add(1)
@@ -606,8 +681,8 @@ func TestCartesianProduct(t *testing.T) {
 	s1.Add(3)
 
 	// Add some elements to the second set
-	s2.Add("a")
-	s2.Add("b")
+	s2.Add(10)
+	s2.Add(20)
 
 	// Calculate the cartesian product of s1 and s2
 	cartesianProduct := s1.CartesianProduct(s2)
@@ -626,3 +701,475 @@ func TestCartesianProduct(t *testing.T) {
 
 	// Test if each set in the cartesian product contains the expected elements
 }
+
+func TestSetFuncs(t *testing.T) {
+	// Create a new Set
+	s := NewSetFromSlice([]int{1, 2, 3, 4, 5})
+	even := func(item int) bool { return item%2 == 0 }
+
+	// Test ContainsFunc
+	if !s.ContainsFunc(even) {
+		t.Errorf("Expected set to contain an even item")
+	}
+
+	// Test RemoveAllFunc
+	if removed := s.RemoveAllFunc(even); removed != 2 {
+		t.Errorf("Expected 2 items to be removed, got %d", removed)
+	}
+	if s.ContainsFunc(even) || s.Len() != 3 {
+		t.Errorf("Expected {1 3 5}, got %v", s)
+	}
+}
+
+func TestUnionAll(t *testing.T) {
+	// Test the union of several sets, of one set and of none
+	s1, s2, s3 := NewSetFromSlice([]int{1, 2}), NewSetFromSlice([]int{2, 3}), NewSetFromSlice([]int{4})
+	if got := sortedValues(UnionAll(s1, s2, s3)); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v", got)
+	}
+	union := UnionAll(s1)
+	union.Add(5)
+	if s1.Contains(5) {
+		t.Errorf("Expected the union of one set to be a new set")
+	}
+	if !UnionAll[int]().IsEmpty() {
+		t.Errorf("Expected the union of no sets to be empty")
+	}
+}
+
+func TestIntersectAll(t *testing.T) {
+	// Test the intersection of several sets, whichever is the smallest, and of none
+	s1, s2, s3 := NewSetFromSlice([]int{1, 2, 3, 4}), NewSetFromSlice([]int{2, 3, 4, 5}), NewSetFromSlice([]int{3, 4})
+	for _, sets := range [][]*Set[int]{{s1, s2, s3}, {s3, s2, s1}} {
+		if got := sortedValues(IntersectAll(sets...)); !slices.Equal(got, []int{3, 4}) {
+			t.Errorf("Expected [3 4], got %v", got)
+		}
+	}
+	if !IntersectAll[int]().IsEmpty() {
+		t.Errorf("Expected the intersection of no sets to be empty")
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	// Test the in-place operations with several other sets
+	other1, other2 := NewSetFromSlice([]int{2, 3, 4}), NewSetFromSlice([]int{3, 4, 5})
+	tests := []struct {
+		name     string
+		apply    func(s *Set[int])
+		expected []int
+	}{
+		{"UnionWith", func(s *Set[int]) { s.UnionWith(other1, other2) }, []int{1, 2, 3, 4, 5}},
+		{"IntersectWith", func(s *Set[int]) { s.IntersectWith(other1, other2) }, []int{3}},
+		{"DifferenceWith", func(s *Set[int]) { s.DifferenceWith(other1, other2) }, []int{1}},
+		{"SymmetricDifferenceWith", func(s *Set[int]) { s.SymmetricDifferenceWith(other1, other2) }, []int{1, 3, 5}},
+		{"UnionWith itself", func(s *Set[int]) { s.UnionWith(s) }, []int{1, 2, 3}},
+		{"DifferenceWith itself", func(s *Set[int]) { s.DifferenceWith(s) }, nil},
+		{"SymmetricDifferenceWith itself", func(s *Set[int]) { s.SymmetricDifferenceWith(s) }, nil},
+	}
+	for _, test := range tests {
+		s := NewSetFromSlice([]int{1, 2, 3})
+		test.apply(s)
+		if got := sortedValues(s); !slices.Equal(got, test.expected) {
+			t.Errorf("Expected %v after %s, got %v", test.expected, test.name, got)
+		}
+	}
+	if got := sortedValues(other1); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Errorf("Expected the other sets to be unchanged, got %v", got)
+	}
+}
+
+func TestSetInPlaceOperationsEvents(t *testing.T) {
+	// Test that an in-place operation is delivered as a single notification: a Batch event for several changes,
+	// the event itself for one change, and nothing when the set does not change
+	tests := []struct {
+		name   string
+		apply  func(s *Set[int])
+		kinds  []collections.EventKind
+		events int
+	}{
+		{"several changes", func(s *Set[int]) { s.IntersectWith(NewSetFromSlice([]int{1})) }, []collections.EventKind{collections.Batch}, 2},
+		{"one change", func(s *Set[int]) { s.UnionWith(NewSetFromSlice([]int{3, 4})) }, []collections.EventKind{collections.Added}, 0},
+		{"one removal", func(s *Set[int]) { s.DifferenceWith(NewSetFromSlice([]int{2, 5})) }, []collections.EventKind{collections.Removed}, 0},
+		{"no change", func(s *Set[int]) { s.UnionWith(NewSetFromSlice([]int{1, 2})) }, nil, 0},
+	}
+	for _, test := range tests {
+		s := NewSetFromSlice([]int{1, 2, 3})
+		var kinds []collections.EventKind
+		events := 0
+		s.Subscribe(func(event collections.Event[int]) {
+			kinds = append(kinds, event.Kind)
+			events += len(event.Events)
+		})
+		test.apply(s)
+		if !slices.Equal(kinds, test.kinds) || events != test.events {
+			t.Errorf("Expected %v with %d batched events for %s, got %v with %d", test.kinds, test.events, test.name, kinds, events)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	// Test the sizes and similarity metrics of overlapping, disjoint, nested and empty sets
+	tests := []struct {
+		name                   string
+		s1, s2                 []int
+		intersection, union    int
+		jaccard, dice, overlap float64
+	}{
+		{"overlapping", []int{1, 2, 3, 4}, []int{3, 4, 5}, 2, 5, 2.0 / 5, 4.0 / 7, 2.0 / 3},
+		{"disjoint", []int{1, 2}, []int{3}, 0, 3, 0, 0, 0},
+		{"nested", []int{1, 2, 3}, []int{2, 3}, 2, 3, 2.0 / 3, 4.0 / 5, 1},
+		{"equal", []int{1, 2}, []int{2, 1}, 2, 2, 1, 1, 1},
+		{"one empty", []int{1}, nil, 0, 1, 0, 0, 0},
+		{"both empty", nil, nil, 0, 0, 1, 1, 1},
+	}
+	for _, test := range tests {
+		s1, s2 := NewSetFromSlice(test.s1), NewSetFromSlice(test.s2)
+		if got := s1.IntersectionSize(s2); got != test.intersection {
+			t.Errorf("Expected IntersectionSize %d for %s sets, got %d", test.intersection, test.name, got)
+		}
+		if got := s1.UnionSize(s2); got != test.union {
+			t.Errorf("Expected UnionSize %d for %s sets, got %d", test.union, test.name, got)
+		}
+		if got := s1.Jaccard(s2); got != test.jaccard {
+			t.Errorf("Expected Jaccard %v for %s sets, got %v", test.jaccard, test.name, got)
+		}
+		if got := s1.Dice(s2); got != test.dice {
+			t.Errorf("Expected Dice %v for %s sets, got %v", test.dice, test.name, got)
+		}
+		if got := s1.Overlap(s2); got != test.overlap {
+			t.Errorf("Expected Overlap %v for %s sets, got %v", test.overlap, test.name, got)
+		}
+		if s1.Jaccard(s2) != s2.Jaccard(s1) || s1.Overlap(s2) != s2.Overlap(s1) {
+			t.Errorf("Expected the metrics of %s sets to be symmetric", test.name)
+		}
+	}
+}
+
+func TestSetSubscribe(t *testing.T) {
+	// Test that changes are delivered as events with no index, and that no-op changes are not
+	s := NewSet[int]()
+	var events []collections.Event[int]
+	unsubscribe := s.Subscribe(func(event collections.Event[int]) { events = append(events, event) })
+
+	s.Add(1)
+	s.Add(1)
+	s.Remove(2)
+	s.Remove(1)
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %v", events)
+	}
+	if events[0].Kind != collections.Added || events[0].Value != 1 || events[0].Index != -1 {
+		t.Errorf("Expected Added 1 at -1, got %+v", events[0])
+	}
+	if events[1].Kind != collections.Removed || events[1].Value != 1 || events[1].Index != -1 {
+		t.Errorf("Expected Removed 1 at -1, got %+v", events[1])
+	}
+
+	// Test that bulk operations are delivered as a single Batch event
+	events = nil
+	s.FromSlice([]int{1, 2, 3, 4})
+	s.RemoveAllFunc(func(item int) bool { return item%2 == 0 })
+	if len(events) != 2 || events[0].Kind != collections.Batch || events[1].Kind != collections.Batch {
+		t.Fatalf("Expected 2 Batch events, got %v", events)
+	}
+	if len(events[0].Events) != 4 || len(events[1].Events) != 2 {
+		t.Errorf("Expected batches of 4 and 2 events, got %d and %d", len(events[0].Events), len(events[1].Events))
+	}
+
+	events = nil
+	s.Clear()
+	s.Clear()
+	if len(events) != 1 || events[0].Kind != collections.Cleared {
+		t.Errorf("Expected a single Cleared event, got %v", events)
+	}
+
+	unsubscribe()
+	events = nil
+	s.Add(5)
+	if len(events) != 0 {
+		t.Errorf("Expected no events after unsubscribing, got %v", events)
+	}
+}
+
+func TestSetConformance(t *testing.T) {
+	// Test that Set behaves like every other SetLike
+	testkit.TestSet(t, func() collections.SetLike[int] { return NewSet[int]() },
+		func(i int) int { return i },
+		testkit.WithClone(func(c collections.Collection[int]) collections.Collection[int] { return c.(*Set[int]).Clone() }))
+}
+
+// fuzzableSet is a set of ints with the set operations that fuzzSet compares against a map.
+type fuzzableSet[S any] interface {
+	collections.SetLike[int]
+	Union(other S) S
+	Intersection(other S) S
+	Difference(other S) S
+	SymmetricDifference(other S) S
+}
+
+// sortedValues returns the values of s in ascending order.
+func sortedValues(s collections.Collection[int]) []int {
+	values := s.Values()
+	slices.Sort(values)
+	return values
+}
+
+// modelValues returns the keys of the model in ascending order.
+func modelValues(model map[int]bool) []int {
+	values := make([]int, 0, len(model))
+	for v := range model {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	return values
+}
+
+// fuzzSet applies the operations decoded from data to two sets returned by newSet and to two maps,
+// and compares them after every operation. value maps an operation argument to an element.
+func fuzzSet[S fuzzableSet[S]](t *testing.T, data []byte, newSet func() S, value func(arg int) int) {
+	s, other := newSet(), newSet()
+	model, otherModel := make(map[int]bool), make(map[int]bool)
+	for _, op := range testkit.DecodeOps(data, 10) {
+		v := value(op.Arg)
+		switch op.Code {
+		case 0:
+			s.Add(v)
+			model[v] = true
+		case 1:
+			s.Remove(v)
+			delete(model, v)
+		case 2:
+			if s.Contains(v) != model[v] {
+				t.Fatalf("Expected Contains(%d) on %v to be %v", v, modelValues(model), model[v])
+			}
+		case 3:
+			other.Add(v)
+			otherModel[v] = true
+		case 4:
+			expected := make(map[int]bool)
+			for x := range model {
+				expected[x] = true
+			}
+			for x := range otherModel {
+				expected[x] = true
+			}
+			if got := sortedValues(s.Union(other)); !slices.Equal(got, modelValues(expected)) {
+				t.Fatalf("Expected union %v, got %v", modelValues(expected), got)
+			}
+		case 5, 6, 7:
+			expected := make(map[int]bool)
+			for x := range model {
+				if op.Code == 5 && otherModel[x] || op.Code != 5 && !otherModel[x] {
+					expected[x] = true
+				}
+			}
+			result := s.Intersection(other)
+			if op.Code == 6 {
+				result = s.Difference(other)
+			}
+			if op.Code == 7 {
+				for x := range otherModel {
+					if !model[x] {
+						expected[x] = true
+					}
+				}
+				result = s.SymmetricDifference(other)
+			}
+			if got := sortedValues(result); !slices.Equal(got, modelValues(expected)) {
+				t.Fatalf("Expected %v for operation %d, got %v", modelValues(expected), op.Code, got)
+			}
+		case 8:
+			// Add a long run of consecutive elements
+			for x := v; x < v+4200; x++ {
+				s.Add(x)
+				model[x] = true
+			}
+		case 9:
+			for x := v; x < v+4200; x++ {
+				s.Remove(x)
+				delete(model, x)
+			}
+		}
+		if got := sortedValues(s); !slices.Equal(got, modelValues(model)) || s.Len() != len(model) {
+			t.Fatalf("Expected %v after %+v, got %v", modelValues(model), op, got)
+		}
+		if got := sortedValues(other); !slices.Equal(got, modelValues(otherModel)) {
+			t.Fatalf("Expected other set to be %v after %+v, got %v", modelValues(otherModel), op, got)
+		}
+	}
+}
+
+func FuzzSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 3, 2, 3, 5, 4, 0, 5, 0, 6, 0, 7, 0, 1, 1, 2, 1})
+	f.Add([]byte{8, 0, 3, 10, 9, 5, 4, 0, 7, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzSet(t, data, NewSet[int], func(arg int) int { return arg })
+	})
+}
+
+// benchSink keeps the compiler from optimizing away the results of benchmarked operations.
+var benchSink interface{}
+
+// newBenchSet returns a set holding the integers from 0 to n-1.
+func newBenchSet(n int) *Set[int] {
+	s := NewSet[int]()
+	for i := 0; i < n; i++ {
+		s.Add(i)
+	}
+	return s
+}
+
+// newBenchMap returns a map holding the integers from 0 to n-1 as keys.
+func newBenchMap(n int) map[int]struct{} {
+	m := make(map[int]struct{}, n)
+	for i := 0; i < n; i++ {
+		m[i] = struct{}{}
+	}
+	return m
+}
+
+func BenchmarkSetAddRemove(b *testing.B) {
+	testkit.Bench(b, "Set", func(b *testing.B, n int) {
+		s := newBenchSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s.Add(n + i)
+			s.Remove(n + i)
+		}
+	})
+	testkit.Bench(b, "map", func(b *testing.B, n int) {
+		m := newBenchMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m[n+i] = struct{}{}
+			delete(m, n+i)
+		}
+	})
+}
+
+func BenchmarkSetContains(b *testing.B) {
+	testkit.Bench(b, "Set", func(b *testing.B, n int) {
+		s := newBenchSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s.Contains(i % n)
+		}
+	})
+	testkit.Bench(b, "map", func(b *testing.B, n int) {
+		m := newBenchMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, benchSink = m[i%n]
+		}
+	})
+}
+
+func BenchmarkSetUnion(b *testing.B) {
+	testkit.Bench(b, "Set", func(b *testing.B, n int) {
+		s1, s2 := newBenchSet(n), newBenchSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s1.Union(s2)
+		}
+	})
+	testkit.Bench(b, "map", func(b *testing.B, n int) {
+		m1, m2 := newBenchMap(n), newBenchMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			union := make(map[int]struct{})
+			for k := range m1 {
+				union[k] = struct{}{}
+			}
+			for k := range m2 {
+				union[k] = struct{}{}
+			}
+			benchSink = union
+		}
+	})
+}
+
+func BenchmarkSetIntersection(b *testing.B) {
+	testkit.Bench(b, "Set", func(b *testing.B, n int) {
+		s1, s2 := newBenchSet(n), newBenchSet(n/2)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s1.Intersection(s2)
+		}
+	})
+	testkit.Bench(b, "map", func(b *testing.B, n int) {
+		m1, m2 := newBenchMap(n), newBenchMap(n/2)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			intersection := make(map[int]struct{})
+			for k := range m1 {
+				if _, ok := m2[k]; ok {
+					intersection[k] = struct{}{}
+				}
+			}
+			benchSink = intersection
+		}
+	})
+}
+
+// newBenchShards returns 8 disjoint sets holding the integers from 0 to n-1 between them.
+func newBenchShards(n int) []*Set[int] {
+	shards := make([]*Set[int], 8)
+	for i := range shards {
+		shards[i] = NewSet[int]()
+	}
+	for i := 0; i < n; i++ {
+		shards[i%len(shards)].Add(i)
+	}
+	return shards
+}
+
+func BenchmarkSetUnionAll(b *testing.B) {
+	testkit.Bench(b, "UnionAll", func(b *testing.B, n int) {
+		shards := newBenchShards(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = UnionAll(shards...)
+		}
+	})
+	testkit.Bench(b, "UnionWith", func(b *testing.B, n int) {
+		shards := newBenchShards(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			union := NewSet[int]()
+			union.UnionWith(shards...)
+			benchSink = union
+		}
+	})
+	testkit.Bench(b, "Union", func(b *testing.B, n int) {
+		shards := newBenchShards(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			union := shards[0]
+			for _, shard := range shards[1:] {
+				union = union.Union(shard)
+			}
+			benchSink = union
+		}
+	})
+}
+
+func BenchmarkSetValues(b *testing.B) {
+	testkit.Bench(b, "Set", func(b *testing.B, n int) {
+		s := newBenchSet(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			benchSink = s.Values()
+		}
+	})
+	testkit.Bench(b, "map", func(b *testing.B, n int) {
+		m := newBenchMap(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			values := make([]int, 0, len(m))
+			for k := range m {
+				values = append(values, k)
+			}
+			benchSink = values
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package set
+
+import (
+	"errors"
+
+	"goCollections/collections"
+)
+
+// Tx is a transaction on a Set, created by Begin. It has the same methods as the set for reading and
+// changing it: reads see the changes of the transaction, and the set is unchanged until Commit.
+// The transaction only keeps its changes, so beginning one takes constant time. It holds no resources
+// of the set, so one that is never finished is simply garbage collected.
+type Tx[T comparable] struct {
+	set      *Set[T]
+	added    map[T]struct{} // The items added by the transaction that are not in the set.
+	removed  map[T]struct{} // The items of the set removed by the transaction.
+	cleared  bool           // Whether the transaction removed every item of the set.
+	modCount int            // The modification count of the set when the transaction began.
+	done     bool           // Whether Commit or Rollback was called.
+}
+
+var _ collections.SetLike[int] = (*Tx[int])(nil)
+
+// Begin starts a transaction on the set.
+// The set must not be changed while the transaction is open, or Commit fails. Changes are detected with
+// a modification count, so the transaction does not subscribe to the set.
+func (s *Set[T]) Begin() *Tx[T] {
+	return &Tx[T]{set: s, added: make(map[T]struct{}), removed: make(map[T]struct{}), modCount: s.modCount}
+}
+
+// finish ends the transaction.
+func (tx *Tx[T]) finish() error {
+	if tx.done {
+		return errors.New("transaction is already finished")
+	}
+	tx.done = true
+	return nil
+}
+
+// reset discards the changes of the transaction, so that it reads the set again.
+func (tx *Tx[T]) reset() {
+	tx.added = make(map[T]struct{})
+	tx.removed = make(map[T]struct{})
+	tx.cleared = false
+}
+
+// Commit applies the changes of the transaction to the set, and delivers them to its subscribers
+// as a single notification. It returns an error, and leaves the set unchanged, if the set was
+// changed outside of the transaction or the transaction is already finished.
+func (tx *Tx[T]) Commit() error {
+	if err := tx.finish(); err != nil {
+		return err
+	}
+	s := tx.set
+	if s.modCount != tx.modCount {
+		return errors.New("set was modified outside of the transaction")
+	}
+	s.observers.Batch(func() {
+		if tx.cleared {
+			s.Clear()
+		}
+		for item := range tx.removed {
+			s.Remove(item)
+		}
+		for item := range tx.added {
+			s.Add(item)
+		}
+	})
+	tx.reset()
+	return nil
+}
+
+// Rollback discards the changes of the transaction. It returns an error if the transaction is already finished.
+func (tx *Tx[T]) Rollback() error {
+	if err := tx.finish(); err != nil {
+		return err
+	}
+	tx.reset()
+	return nil
+}
+
+// Add adds an item to the set in the transaction.
+func (tx *Tx[T]) Add(item T) {
+	if _, ok := tx.removed[item]; ok {
+		delete(tx.removed, item)
+	} else if !tx.Contains(item) {
+		tx.added[item] = struct{}{}
+	}
+}
+
+// Remove removes the specified item from the set in the transaction.
+func (tx *Tx[T]) Remove(item T) {
+	if _, ok := tx.added[item]; ok {
+		delete(tx.added, item)
+	} else if !tx.cleared && tx.set.Contains(item) {
+		tx.removed[item] = struct{}{}
+	}
+}
+
+// RemoveAllFunc removes every item for which match returns true from the set in the transaction.
+// It returns the number of removed items.
+func (tx *Tx[T]) RemoveAllFunc(match func(item T) bool) int {
+	removed := 0
+	for _, item := range tx.Values() {
+		if match(item) {
+			tx.Remove(item)
+			removed++
+		}
+	}
+	return removed
+}
+
+// FromSlice adds all the elements from the given slice to the set in the transaction.
+func (tx *Tx[T]) FromSlice(slice []T) {
+	for _, item := range slice {
+		tx.Add(item)
+	}
+}
+
+// Clear removes all elements from the set in the transaction.
+func (tx *Tx[T]) Clear() {
+	tx.reset()
+	tx.cleared = true
+}
+
+// Contains checks if the set in the transaction contains the specified item.
+func (tx *Tx[T]) Contains(item T) bool {
+	if _, ok := tx.added[item]; ok {
+		return true
+	}
+	if _, ok := tx.removed[item]; ok || tx.cleared {
+		return false
+	}
+	return tx.set.Contains(item)
+}
+
+// Len returns the number of elements in the set in the transaction.
+func (tx *Tx[T]) Len() int {
+	if tx.cleared {
+		return len(tx.added)
+	}
+	return tx.set.Len() - len(tx.removed) + len(tx.added)
+}
+
+// IsEmpty checks if the set in the transaction is empty.
+func (tx *Tx[T]) IsEmpty() bool {
+	return tx.Len() == 0
+}
+
+// Values returns a slice containing all the elements in the set in the transaction.
+// The order of the elements in the slice is not guaranteed.
+func (tx *Tx[T]) Values() []T {
+	values := make([]T, 0, tx.Len())
+	if !tx.cleared {
+		for item := range tx.set.elements {
+			if _, ok := tx.removed[item]; !ok {
+				values = append(values, item)
+			}
+		}
+	}
+	for item := range tx.added {
+		values = append(values, item)
+	}
+	return values
+}
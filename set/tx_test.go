@@ -0,0 +1,201 @@
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"goCollections/collections"
+	"goCollections/testkit"
+)
+
+func TestTxCommit(t *testing.T) {
+	// Test that the set is unchanged until commit, while the transaction sees its own changes
+	s := NewSetFromSlice([]int{1, 2, 3})
+	tx := s.Begin()
+	tx.Add(4)
+	tx.Remove(1)
+	tx.Remove(5)
+	tx.Add(2)
+	if !tx.Contains(4) || tx.Contains(1) || tx.Len() != 3 {
+		t.Errorf("Expected the transaction to hold [2 3 4], got %v", tx.Values())
+	}
+	if s.Contains(4) || !s.Contains(1) || s.Len() != 3 {
+		t.Errorf("Expected the set to be unchanged before commit, got %v", s.ToSlice())
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	values := s.ToSlice()
+	slices.Sort(values)
+	if !slices.Equal(values, []int{2, 3, 4}) {
+		t.Errorf("Expected [2 3 4] after commit, got %v", values)
+	}
+	if err := tx.Commit(); err == nil {
+		t.Errorf("Expected an error when committing twice")
+	}
+}
+
+func TestTxRollback(t *testing.T) {
+	// Test that a rolled back transaction leaves the set unchanged
+	s := NewSetFromSlice([]int{1, 2, 3})
+	tx := s.Begin()
+	tx.Clear()
+	tx.FromSlice([]int{7, 8})
+	if n := tx.RemoveAllFunc(func(item int) bool { return item == 8 }); n != 1 {
+		t.Errorf("Expected 1 removed item, got %d", n)
+	}
+	if tx.Contains(1) || !tx.Contains(7) || tx.Len() != 1 {
+		t.Errorf("Expected the transaction to hold [7], got %v", tx.Values())
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if s.Len() != 3 || s.Contains(7) {
+		t.Errorf("Expected the set to be unchanged after rollback, got %v", s.ToSlice())
+	}
+	if err := tx.Commit(); err == nil {
+		t.Errorf("Expected an error when committing after rollback")
+	}
+}
+
+func TestTxConflict(t *testing.T) {
+	// Test that commit fails if the set was changed outside of the transaction
+	s := NewSetFromSlice([]int{1})
+	tx := s.Begin()
+	tx.Add(2)
+	s.Add(3)
+	if err := tx.Commit(); err == nil {
+		t.Errorf("Expected an error after an outside change")
+	}
+	if s.Contains(2) {
+		t.Errorf("Expected the set to be unchanged by the failed commit")
+	}
+}
+
+func TestTxConflictBetweenTransactions(t *testing.T) {
+	// Test that a commit fails if another transaction committed changes first, and that a no-op change
+	// outside of the transaction is not a conflict
+	s := NewSetFromSlice([]int{1})
+	tx1, tx2 := s.Begin(), s.Begin()
+	s.Add(1)
+	s.Remove(2)
+	tx1.Add(2)
+	tx2.Add(3)
+	if err := tx1.Commit(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := tx2.Commit(); err == nil {
+		t.Errorf("Expected an error after the commit of another transaction")
+	}
+}
+
+func TestTxAbandoned(t *testing.T) {
+	// Test that an abandoned transaction leaves nothing behind on the set
+	s := NewSet[int]()
+	for i := 0; i < 10; i++ {
+		s.Begin().Add(i)
+	}
+	if s.observers.Active() {
+		t.Errorf("Expected no subscribers after abandoning transactions")
+	}
+	s.Add(1)
+	tx := s.Begin()
+	defer tx.Rollback()
+	tx.Add(2)
+	if err := tx.Commit(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestTxEvents(t *testing.T) {
+	// Test that subscribers receive the changes of a commit as a single notification
+	s := NewSetFromSlice([]int{1, 2})
+	var events []collections.Event[int]
+	s.Subscribe(func(event collections.Event[int]) { events = append(events, event) })
+	tx := s.Begin()
+	tx.Clear()
+	tx.Add(3)
+	tx.Commit()
+	if len(events) != 1 || events[0].Kind != collections.Batch || len(events[0].Events) != 2 {
+		t.Fatalf("Expected a Batch event of Cleared and Added, got %v", events)
+	}
+	if events[0].Events[0].Kind != collections.Cleared || events[0].Events[1].Value != 3 {
+		t.Errorf("Expected Cleared and Added 3, got %v", events[0].Events)
+	}
+}
+
+func TestTxConformance(t *testing.T) {
+	// Test that a transaction behaves like every other set, on top of a set whose items it removed
+	testkit.TestSet(t, func() collections.SetLike[int] {
+		tx := NewSetFromSlice([]int{-1, -2}).Begin()
+		tx.Remove(-1)
+		tx.Remove(-2)
+		return tx
+	}, func(i int) int { return i })
+}
+
+func FuzzTx(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 6, 0, 1, 1, 0, 3, 4, 0, 5, 0})
+	f.Add([]byte{0, 1, 6, 0, 3, 0, 0, 2, 2, 1, 5, 0, 0, 4})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Apply the operations to a set and its open transaction, and to two maps, and compare them after every operation
+		s := NewSet[int]()
+		tx := s.Begin()
+		committed, model := make(map[int]bool), make(map[int]bool)
+		for _, op := range testkit.DecodeOps(data, 7) {
+			switch op.Code {
+			case 0:
+				tx.Add(op.Arg)
+				model[op.Arg] = true
+			case 1:
+				tx.Remove(op.Arg)
+				delete(model, op.Arg)
+			case 2:
+				if tx.Contains(op.Arg) != model[op.Arg] {
+					t.Fatalf("Expected Contains(%d) on %v to be %v", op.Arg, modelValues(model), model[op.Arg])
+				}
+			case 3:
+				tx.Clear()
+				model = make(map[int]bool)
+			case 4:
+				if err := tx.Commit(); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				committed = model
+				tx = s.Begin()
+				model = make(map[int]bool)
+				for v := range committed {
+					model[v] = true
+				}
+			case 5:
+				if err := tx.Rollback(); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				tx = s.Begin()
+				model = make(map[int]bool)
+				for v := range committed {
+					model[v] = true
+				}
+			case 6:
+				expected := 0
+				for v := range model {
+					if v%2 == 0 {
+						delete(model, v)
+						expected++
+					}
+				}
+				if n := tx.RemoveAllFunc(func(item int) bool { return item%2 == 0 }); n != expected {
+					t.Fatalf("Expected RemoveAllFunc to remove %d items, got %d", expected, n)
+				}
+			}
+			if got := sortedValues(tx); !slices.Equal(got, modelValues(model)) || tx.Len() != len(model) {
+				t.Fatalf("Expected %v in the transaction after %+v, got %v", modelValues(model), op, got)
+			}
+			if got := sortedValues(s); !slices.Equal(got, modelValues(committed)) {
+				t.Fatalf("Expected %v in the set after %+v, got %v", modelValues(committed), op, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package testkit
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Sizes are the collection sizes that benchmarks run at, from 10 to 1M.
+var Sizes = []int{10, 100, 1_000, 10_000, 100_000, 1_000_000}
+
+// Bench runs fn as a sub-benchmark for every size in Sizes, with allocation reporting.
+// The sub-benchmarks are named impl=<impl>/size=<n>, so that benchstat can compare
+// implementations with -col /impl and filter sizes with -filter.
+// fn must set up a collection of n elements, call b.ResetTimer, and then run b.N operations.
+func Bench(b *testing.B, impl string, fn func(b *testing.B, n int)) {
+	for _, n := range Sizes {
+		b.Run(fmt.Sprintf("impl=%s/size=%d", impl, n), func(b *testing.B) {
+			b.ReportAllocs()
+			fn(b, n)
+		})
+	}
+}
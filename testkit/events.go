@@ -0,0 +1,30 @@
+package testkit
+
+import (
+	"slices"
+
+	"goCollections/collections"
+)
+
+// Replay applies event to values, the elements of an ordered collection before the change,
+// and returns the elements after the change. The events of a Batch event are applied in order.
+// Keeping a slice up to date with Replay from a subscriber, and comparing it with the collection,
+// checks that the collection reports every change with the right index.
+// It panics if an index is out of range for values.
+func Replay[T any](values []T, event collections.Event[T]) []T {
+	switch event.Kind {
+	case collections.Added:
+		return slices.Insert(values, event.Index, event.Value)
+	case collections.Removed:
+		return slices.Delete(values, event.Index, event.Index+1)
+	case collections.Updated:
+		values[event.Index] = event.Value
+	case collections.Cleared:
+		return values[:0]
+	case collections.Batch:
+		for _, e := range event.Events {
+			values = Replay(values, e)
+		}
+	}
+	return values
+}
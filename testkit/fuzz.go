@@ -0,0 +1,19 @@
+package testkit
+
+// Op is an operation decoded from fuzz input: an operation code and a signed argument.
+type Op struct {
+	Code int // The operation to apply, between 0 and the number of operations.
+	Arg  int // The argument of the operation, between -128 and 127, used as a value or an index.
+}
+
+// DecodeOps decodes fuzz input into a sequence of operations, two bytes each. The first byte selects
+// one of n operations, and the second byte is the argument. A trailing odd byte is ignored.
+// The argument can be negative or larger than the collection, so that fuzz targets also exercise
+// the out-of-range paths.
+func DecodeOps(data []byte, n int) []Op {
+	ops := make([]Op, len(data)/2)
+	for i := range ops {
+		ops[i] = Op{Code: int(data[2*i]) % n, Arg: int(int8(data[2*i+1]))}
+	}
+	return ops
+}
@@ -0,0 +1,466 @@
+// Package testkit provides conformance tests for implementations of the collections interfaces.
+// Given a constructor, each Test function runs a standard battery of behavioral tests as subtests,
+// so any implementation, in this module or outside of it, can prove that it behaves like the others.
+package testkit
+
+import (
+	"testing"
+
+	"goCollections/collections"
+)
+
+// EqualFunc reports whether two values are equal.
+type EqualFunc[T any] func(a, b T) bool
+
+// Option configures the conformance tests.
+type Option[T any] func(*config[T])
+
+// config holds the settings applied by Option functions.
+type config[T any] struct {
+	equal      EqualFunc[T]
+	ordered    bool
+	clone      func(c collections.Collection[T]) collections.Collection[T]
+	equivalent func(i int) T
+}
+
+// WithEqual sets the function the tests use to compare values instead of ==.
+// It is required when the values are of a type that == cannot compare.
+func WithEqual[T any](equal EqualFunc[T]) Option[T] {
+	return func(c *config[T]) {
+		c.equal = equal
+	}
+}
+
+// Ordered makes the tests check that Values returns the elements in the order they were added.
+// Lists and queues are always checked for order; sets and collections only with this option.
+func Ordered[T any]() Option[T] {
+	return func(c *config[T]) {
+		c.ordered = true
+	}
+}
+
+// WithClone adds tests that check that a collection returned by clone is independent of the original.
+func WithClone[T any](clone func(c collections.Collection[T]) collections.Collection[T]) Option[T] {
+	return func(c *config[T]) {
+		c.clone = clone
+	}
+}
+
+// WithEquivalent adds tests that check that Contains and IndexOf use the equality function of the collection,
+// such as one set with a WithEqual option of the collection, instead of ==. equivalent must return a value
+// that is not == to value(i) but that the collection considers equal to it, and that it considers different
+// from value(j) for every other j.
+func WithEquivalent[T any](equivalent func(i int) T) Option[T] {
+	return func(c *config[T]) {
+		c.equivalent = equivalent
+	}
+}
+
+// container is implemented by collections that can check if they hold a value.
+type container[T any] interface {
+	Contains(value T) bool
+}
+
+// indexer is implemented by collections that can find the index of a value.
+type indexer[T any] interface {
+	IndexOf(value T) int
+}
+
+// newConfig creates a config with the given options applied.
+func newConfig[T any](opts []Option[T]) *config[T] {
+	c := &config[T]{equal: func(a, b T) bool { return any(a) == any(b) }}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// valuesOf returns n distinct values produced by value.
+func valuesOf[T any](n int, value func(i int) T) []T {
+	values := make([]T, n)
+	for i := range values {
+		values[i] = value(i)
+	}
+	return values
+}
+
+// expectValues reports an error if the collection does not hold exactly the expected values.
+// Unless the config is ordered, the values may be in any order.
+func (cfg *config[T]) expectValues(t *testing.T, c collections.Collection[T], expected []T) {
+	t.Helper()
+	values := c.Values()
+	if c.Len() != len(expected) {
+		t.Errorf("Expected Len to be %d, got %d", len(expected), c.Len())
+	}
+	if len(values) != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+		return
+	}
+	if cfg.ordered {
+		for i := range expected {
+			if !cfg.equal(values[i], expected[i]) {
+				t.Errorf("Expected %v, got %v", expected, values)
+				return
+			}
+		}
+		return
+	}
+	found := make([]bool, len(values))
+	for _, e := range expected {
+		match := false
+		for i, v := range values {
+			if !found[i] && cfg.equal(v, e) {
+				found[i] = true
+				match = true
+				break
+			}
+		}
+		if !match {
+			t.Errorf("Expected %v in any order, got %v", expected, values)
+			return
+		}
+	}
+}
+
+// TestCollection runs the Collection conformance tests. newCollection must return a new collection
+// holding the given values, and value must return distinct values for distinct indexes.
+func TestCollection[T any](t *testing.T, newCollection func(values ...T) collections.Collection[T], value func(i int) T, opts ...Option[T]) {
+	testCollection(t, newCollection, value, newConfig(opts))
+}
+
+// testCollection runs the Collection conformance tests with the given config.
+func testCollection[T any](t *testing.T, newCollection func(values ...T) collections.Collection[T], value func(i int) T, cfg *config[T]) {
+	testEquality(t, newCollection, value, cfg)
+
+	t.Run("Empty", func(t *testing.T) {
+		c := newCollection()
+		if c.Len() != 0 || !c.IsEmpty() {
+			t.Errorf("Expected a new collection to be empty, got Len %d", c.Len())
+		}
+		if len(c.Values()) != 0 {
+			t.Errorf("Expected no values, got %v", c.Values())
+		}
+		c.Clear()
+		if !c.IsEmpty() {
+			t.Errorf("Expected collection to be empty after Clear")
+		}
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		expected := valuesOf(5, value)
+		c := newCollection(expected...)
+		if c.IsEmpty() {
+			t.Errorf("Expected collection to not be empty")
+		}
+		cfg.expectValues(t, c, expected)
+
+		// Values must return a new slice
+		values := c.Values()
+		values[0] = value(len(expected))
+		cfg.expectValues(t, c, expected)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		c := newCollection(valuesOf(5, value)...)
+		c.Clear()
+		if c.Len() != 0 || !c.IsEmpty() || len(c.Values()) != 0 {
+			t.Errorf("Expected collection to be empty after Clear, got %v", c.Values())
+		}
+	})
+
+	if cfg.clone == nil {
+		return
+	}
+	t.Run("Clone", func(t *testing.T) {
+		expected := valuesOf(5, value)
+		c := newCollection(expected...)
+		clone := cfg.clone(c)
+		cfg.expectValues(t, clone, expected)
+
+		// Clearing the clone must not affect the original, and the other way around
+		clone.Clear()
+		cfg.expectValues(t, c, expected)
+		clone = cfg.clone(c)
+		c.Clear()
+		cfg.expectValues(t, clone, expected)
+	})
+}
+
+// testEquality runs the tests of Contains and IndexOf, for the collections that have them.
+// IndexOf is only checked for ordered collections, where an index is meaningful.
+func testEquality[T any](t *testing.T, newCollection func(values ...T) collections.Collection[T], value func(i int) T, cfg *config[T]) {
+	t.Run("Equality", func(t *testing.T) {
+		v := valuesOf(4, value)
+		c := newCollection(v[:3]...)
+		contains, hasContains := c.(container[T])
+		index, hasIndexOf := c.(indexer[T])
+		hasIndexOf = hasIndexOf && cfg.ordered
+		if !hasContains && !hasIndexOf {
+			t.Skip("The collection has neither Contains nor IndexOf")
+		}
+
+		// lookup checks Contains and IndexOf for a value, which is at index i or missing if i is -1.
+		lookup := func(x T, i int) {
+			t.Helper()
+			if hasContains {
+				if got := contains.Contains(x); got != (i >= 0) {
+					t.Errorf("Expected Contains(%v) to be %v, got %v", x, i >= 0, got)
+				}
+			}
+			if hasIndexOf {
+				if got := index.IndexOf(x); got != i {
+					t.Errorf("Expected IndexOf(%v) to be %d, got %d", x, i, got)
+				}
+			}
+		}
+		for i := 0; i < 3; i++ {
+			lookup(v[i], i)
+		}
+		lookup(v[3], -1)
+
+		if cfg.equivalent == nil {
+			return
+		}
+		for i := 0; i < 3; i++ {
+			lookup(cfg.equivalent(i), i)
+		}
+		lookup(cfg.equivalent(3), -1)
+	})
+}
+
+// TestList runs the List conformance tests, including the Collection tests, against the lists
+// returned by newList. newList must return a new empty list, and value must return distinct values
+// for distinct indexes.
+func TestList[T any](t *testing.T, newList func() collections.List[T], value func(i int) T, opts ...Option[T]) {
+	cfg := newConfig(opts)
+	cfg.ordered = true
+
+	// newListOf returns a new list holding the given values.
+	newListOf := func(values ...T) collections.List[T] {
+		l := newList()
+		for _, v := range values {
+			if err := l.InsertAt(l.Len(), v); err != nil {
+				t.Fatalf("Expected no error inserting at the end, got %v", err)
+			}
+		}
+		return l
+	}
+
+	t.Run("Collection", func(t *testing.T) {
+		testCollection(t, func(values ...T) collections.Collection[T] { return newListOf(values...) }, value, cfg)
+	})
+
+	t.Run("InsertAt", func(t *testing.T) {
+		v := valuesOf(4, value)
+		l := newList()
+		if err := l.InsertAt(0, v[1]); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if err := l.InsertAt(0, v[0]); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if err := l.InsertAt(2, v[3]); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if err := l.InsertAt(2, v[2]); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		cfg.expectValues(t, l, v)
+	})
+
+	t.Run("GetAndSet", func(t *testing.T) {
+		v := valuesOf(4, value)
+		l := newListOf(v[:3]...)
+		for i := 0; i < 3; i++ {
+			if got, err := l.Get(i); err != nil || !cfg.equal(got, v[i]) {
+				t.Errorf("Expected %v at index %d, got %v (%v)", v[i], i, got, err)
+			}
+		}
+		if err := l.Set(1, v[3]); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		cfg.expectValues(t, l, []T{v[0], v[3], v[2]})
+	})
+
+	t.Run("RemoveAt", func(t *testing.T) {
+		v := valuesOf(5, value)
+		l := newListOf(v...)
+		if err := l.RemoveAt(0); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if err := l.RemoveAt(l.Len() - 1); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if err := l.RemoveAt(1); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		cfg.expectValues(t, l, []T{v[1], v[3]})
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		v := valuesOf(4, value)
+		empty := newList()
+		if _, err := empty.Get(0); err == nil {
+			t.Errorf("Expected an error getting from an empty list, got nil")
+		}
+		if err := empty.RemoveAt(0); err == nil {
+			t.Errorf("Expected an error removing from an empty list, got nil")
+		}
+
+		l := newListOf(v[:3]...)
+		for _, index := range []int{-1, 3} {
+			if _, err := l.Get(index); err == nil {
+				t.Errorf("Expected an error getting index %d, got nil", index)
+			}
+			if err := l.Set(index, v[3]); err == nil {
+				t.Errorf("Expected an error setting index %d, got nil", index)
+			}
+			if err := l.RemoveAt(index); err == nil {
+				t.Errorf("Expected an error removing index %d, got nil", index)
+			}
+		}
+		for _, index := range []int{-1, 4} {
+			if err := l.InsertAt(index, v[3]); err == nil {
+				t.Errorf("Expected an error inserting at index %d, got nil", index)
+			}
+		}
+		cfg.expectValues(t, l, v[:3])
+	})
+
+	t.Run("IndexOfDuplicates", func(t *testing.T) {
+		v := valuesOf(2, value)
+		l := newListOf(v[0], v[1], v[0])
+		index, ok := l.(indexer[T])
+		if !ok {
+			t.Skip("The list has no IndexOf")
+		}
+		if got := index.IndexOf(v[0]); got != 0 {
+			t.Errorf("Expected IndexOf to find the first occurrence at 0, got %d", got)
+		}
+		if cfg.equivalent != nil {
+			if got := index.IndexOf(cfg.equivalent(0)); got != 0 {
+				t.Errorf("Expected IndexOf to find the first equivalent value at 0, got %d", got)
+			}
+		}
+	})
+}
+
+// TestSet runs the SetLike conformance tests, including the Collection tests, against the sets
+// returned by newSet. newSet must return a new empty set, and value must return distinct values
+// for distinct indexes and equal values for equal indexes.
+func TestSet[T any](t *testing.T, newSet func() collections.SetLike[T], value func(i int) T, opts ...Option[T]) {
+	cfg := newConfig(opts)
+
+	// newSetOf returns a new set holding the given values.
+	newSetOf := func(values ...T) collections.SetLike[T] {
+		s := newSet()
+		for _, v := range values {
+			s.Add(v)
+		}
+		return s
+	}
+
+	t.Run("Collection", func(t *testing.T) {
+		testCollection(t, func(values ...T) collections.Collection[T] { return newSetOf(values...) }, value, cfg)
+	})
+
+	t.Run("AddAndContains", func(t *testing.T) {
+		s := newSet()
+		if s.Contains(value(0)) {
+			t.Errorf("Expected an empty set to not contain %v", value(0))
+		}
+		s.Add(value(0))
+		s.Add(value(1))
+		if !s.Contains(value(0)) || !s.Contains(value(1)) {
+			t.Errorf("Expected set to contain %v and %v, got %v", value(0), value(1), s.Values())
+		}
+		if s.Contains(value(2)) {
+			t.Errorf("Expected set to not contain %v", value(2))
+		}
+	})
+
+	t.Run("Duplicates", func(t *testing.T) {
+		v := valuesOf(3, value)
+		s := newSetOf(v...)
+		s.Add(value(1))
+		s.Add(value(0))
+		cfg.expectValues(t, s, v)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		v := valuesOf(4, value)
+		s := newSetOf(v[:3]...)
+		s.Remove(v[1])
+		if s.Contains(v[1]) {
+			t.Errorf("Expected set to not contain %v after Remove", v[1])
+		}
+		s.Remove(v[3])
+		cfg.expectValues(t, s, []T{v[0], v[2]})
+
+		// A removed value can be added again
+		s.Add(v[1])
+		cfg.expectValues(t, s, []T{v[0], v[2], v[1]})
+	})
+}
+
+// TestQueue runs the Queue conformance tests, including the Collection tests, against the queues
+// returned by newQueue. newQueue must return a new empty queue, and value must return distinct values
+// for distinct indexes.
+func TestQueue[T any](t *testing.T, newQueue func() collections.Queue[T], value func(i int) T, opts ...Option[T]) {
+	cfg := newConfig(opts)
+	cfg.ordered = true
+
+	// newQueueOf returns a new queue holding the given values.
+	newQueueOf := func(values ...T) collections.Queue[T] {
+		q := newQueue()
+		for _, v := range values {
+			q.Enqueue(v)
+		}
+		return q
+	}
+
+	t.Run("Collection", func(t *testing.T) {
+		testCollection(t, func(values ...T) collections.Collection[T] { return newQueueOf(values...) }, value, cfg)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		q := newQueue()
+		if _, err := q.Dequeue(); err == nil {
+			t.Errorf("Expected an error dequeuing from an empty queue, got nil")
+		}
+		if _, err := q.Head(); err == nil {
+			t.Errorf("Expected an error reading the head of an empty queue, got nil")
+		}
+		if _, err := q.Tail(); err == nil {
+			t.Errorf("Expected an error reading the tail of an empty queue, got nil")
+		}
+	})
+
+	t.Run("FIFO", func(t *testing.T) {
+		v := valuesOf(5, value)
+		q := newQueueOf(v[:3]...)
+		if head, err := q.Head(); err != nil || !cfg.equal(head, v[0]) {
+			t.Errorf("Expected head to be %v, got %v (%v)", v[0], head, err)
+		}
+		if tail, err := q.Tail(); err != nil || !cfg.equal(tail, v[2]) {
+			t.Errorf("Expected tail to be %v, got %v (%v)", v[2], tail, err)
+		}
+
+		// Interleave Enqueue and Dequeue
+		for i := 0; i < 5; i++ {
+			if i+3 < len(v) {
+				q.Enqueue(v[i+3])
+			}
+			got, err := q.Dequeue()
+			if err != nil || !cfg.equal(got, v[i]) {
+				t.Errorf("Expected to dequeue %v, got %v (%v)", v[i], got, err)
+			}
+		}
+		if !q.IsEmpty() {
+			t.Errorf("Expected queue to be empty, got %v", q.Values())
+		}
+		if _, err := q.Dequeue(); err == nil {
+			t.Errorf("Expected an error dequeuing from an empty queue, got nil")
+		}
+	})
+}
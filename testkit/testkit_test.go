@@ -0,0 +1,42 @@
+package testkit
+
+import (
+	"slices"
+	"testing"
+
+	"goCollections/collections"
+)
+
+func TestDecodeOps(t *testing.T) {
+	// Test decoding codes modulo n, signed arguments and a trailing odd byte
+	ops := DecodeOps([]byte{7, 5, 2, 255, 1}, 3)
+	expected := []Op{{Code: 1, Arg: 5}, {Code: 2, Arg: -1}}
+	if len(ops) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, ops)
+	}
+	for i, op := range ops {
+		if op != expected[i] {
+			t.Errorf("Expected operation %d to be %+v, got %+v", i, expected[i], op)
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	// Test replaying every kind of event, including a batch, onto a slice
+	values := []int{1, 2}
+	values = Replay(values, collections.Event[int]{Kind: collections.Added, Index: 1, Value: 5})
+	values = Replay(values, collections.Event[int]{Kind: collections.Updated, Index: 0, Value: 3, Old: 1})
+	values = Replay(values, collections.Event[int]{Kind: collections.Batch, Index: -1, Events: []collections.Event[int]{
+		{Kind: collections.Removed, Index: 2, Value: 2},
+		{Kind: collections.Added, Index: 0, Value: 4},
+	}})
+	expected := []int{4, 3, 5}
+	if !slices.Equal(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+
+	values = Replay(values, collections.Event[int]{Kind: collections.Cleared, Index: -1})
+	if len(values) != 0 {
+		t.Errorf("Expected no values after Cleared, got %v", values)
+	}
+}